@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"im-service/config"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+	"im-service/internal/rpc/friend"
+	"log"
+)
+
+// 一次性迁移：以好友请求和 friends 集合为依据修复好友关系，补齐 pair 字段并创建唯一索引
+// 用法：go run ./cmd/migrate_friends -f etc/im.yaml [-dry-run]
+func main() {
+	configFile := flag.String("f", "etc/im.yaml", "配置文件路径")
+	dryRun := flag.Bool("dry-run", false, "只统计需要修复的好友关系，不写入")
+	flag.Parse()
+
+	var cfg config.Config
+	if err := config.LoadConfig(*configFile, &cfg); err != nil {
+		log.Fatalf("加载配置文件失败: %v", err)
+	}
+	mongoClient, err := mongodb.NewMongoClient(cfg.MongoDB.URI, cfg.MongoDB.Database)
+	if err != nil {
+		log.Fatalf("初始化 MongoDB 失败: %v", err)
+	}
+	defer mongoClient.Close(context.Background())
+	redisClient := redis.NewRedisClient(cfg.Redis.Host, cfg.Redis.Pass)
+
+	result, err := friend.ReconcileFriendships(context.Background(), mongoClient, redisClient, *dryRun)
+	if err != nil {
+		log.Fatalf("修复好友关系失败: %v", err)
+	}
+	log.Printf("好友关系 %d 对，补建 %d，更新 %d，删除 %d", result.Friendships, result.Created, result.Updated, result.Removed)
+	if *dryRun {
+		return
+	}
+	if err := friend.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("创建 MongoDB 索引失败: %v", err)
+	}
+}
@@ -5,18 +5,37 @@ import (
 	"github.com/afex/hystrix-go/hystrix"
 	"github.com/zeromicro/go-zero/zrpc"
 	"gopkg.in/yaml.v3"
+	"im-service/internal/data/storage"
 	"log"
 	"os"
+	"time"
 )
 
 type Config struct {
-	Name       string             `yaml:"Name"`
-	Host       string             `yaml:"Host"`
-	Port       int                `yaml:"Port"`
-	UserRpc    zrpc.RpcClientConf `yaml:"UserRpc"`
-	MessageRpc zrpc.RpcClientConf `yaml:"MessageRpc"`
-	FriendRpc  zrpc.RpcClientConf `yaml:"FriendRpc"`
-	Kafka      struct {
+	Name          string             `yaml:"Name"`
+	Host          string             `yaml:"Host"`
+	Port          int                `yaml:"Port"`
+	NodeID        string             `yaml:"NodeID"`       // 网关节点 ID，多个网关实例之间必须唯一，为空时使用 主机名:端口
+	ServiceToken  string             `yaml:"ServiceToken"` // 网关以服务身份调用后端服务的内部凭证，所有节点必须一致，为空时每次启动随机生成
+	UserRpc       zrpc.RpcClientConf `yaml:"UserRpc"`
+	MessageRpc    zrpc.RpcClientConf `yaml:"MessageRpc"`
+	FriendRpc     zrpc.RpcClientConf `yaml:"FriendRpc"`
+	PresenceRpc   zrpc.RpcClientConf `yaml:"PresenceRpc"`
+	GroupRpc      zrpc.RpcClientConf `yaml:"GroupRpc"`
+	AttachmentRpc zrpc.RpcClientConf `yaml:"AttachmentRpc"`
+	Storage       storage.Config     `yaml:"Storage"`
+	Message       struct {
+		RecallWindow   time.Duration `yaml:"RecallWindow"`   // 发送者可以撤回消息的时限，为 0 时使用默认值
+		Retention      time.Duration `yaml:"Retention"`      // 消息保留时长，超过后不再返回并由后台任务清理，为 0 时永久保留
+		PurgeInterval  time.Duration `yaml:"PurgeInterval"`  // 清理过期消息的间隔，为 0 时使用默认值
+		PurgeBatchSize int           `yaml:"PurgeBatchSize"` // 每批清理的消息数，为 0 时使用默认值
+		ArchiveExpired bool          `yaml:"ArchiveExpired"` // 超过保留时长的普通消息移到 messages_archive 集合而不是直接删除，阅后即焚消息总是直接删除
+	} `yaml:"Message"`
+	Friend struct {
+		RejectCooldown  time.Duration `yaml:"RejectCooldown"`  // 好友请求被拒绝后再次发送需要等待的时间，为 0 时使用默认值
+		SuggestInterval time.Duration `yaml:"SuggestInterval"` // 预先计算好友推荐的间隔，为 0 时使用默认值
+	} `yaml:"Friend"`
+	Kafka struct {
 		Brokers []string `yaml:"Brokers"`
 		Topic   string   `yaml:"Topic"`
 	} `yaml:"Kafka"`
@@ -114,6 +133,57 @@ func LoadConfig(filePath string, cfg *Config) error {
 				}
 			}
 		}
+		// 手动解析 PresenceRpc 的 Endpoints
+		if len(cfg.PresenceRpc.Endpoints) == 0 {
+			var yamlMap map[string]interface{}
+			err = yaml.Unmarshal(data, &yamlMap)
+			if err != nil {
+				return fmt.Errorf("无法重新解组配置文件: %w", err)
+			}
+			if presenceRpc, ok := yamlMap["PresenceRpc"].(map[string]interface{}); ok {
+				if endpoints, ok := presenceRpc["Endpoints"].([]interface{}); ok {
+					for _, endpoint := range endpoints {
+						if endpointStr, ok := endpoint.(string); ok {
+							cfg.PresenceRpc.Endpoints = append(cfg.PresenceRpc.Endpoints, endpointStr)
+						}
+					}
+				}
+			}
+		}
+		// 手动解析 GroupRpc 的 Endpoints
+		if len(cfg.GroupRpc.Endpoints) == 0 {
+			var yamlMap map[string]interface{}
+			err = yaml.Unmarshal(data, &yamlMap)
+			if err != nil {
+				return fmt.Errorf("无法重新解组配置文件: %w", err)
+			}
+			if groupRpc, ok := yamlMap["GroupRpc"].(map[string]interface{}); ok {
+				if endpoints, ok := groupRpc["Endpoints"].([]interface{}); ok {
+					for _, endpoint := range endpoints {
+						if endpointStr, ok := endpoint.(string); ok {
+							cfg.GroupRpc.Endpoints = append(cfg.GroupRpc.Endpoints, endpointStr)
+						}
+					}
+				}
+			}
+		}
+		// 手动解析 AttachmentRpc 的 Endpoints
+		if len(cfg.AttachmentRpc.Endpoints) == 0 {
+			var yamlMap map[string]interface{}
+			err = yaml.Unmarshal(data, &yamlMap)
+			if err != nil {
+				return fmt.Errorf("无法重新解组配置文件: %w", err)
+			}
+			if attachmentRpc, ok := yamlMap["AttachmentRpc"].(map[string]interface{}); ok {
+				if endpoints, ok := attachmentRpc["Endpoints"].([]interface{}); ok {
+					for _, endpoint := range endpoints {
+						if endpointStr, ok := endpoint.(string); ok {
+							cfg.AttachmentRpc.Endpoints = append(cfg.AttachmentRpc.Endpoints, endpointStr)
+						}
+					}
+				}
+			}
+		}
 		//fmt.Printf("反序列化配置: %+v\n", cfg)
 		return nil
 	}, func(err error) error {
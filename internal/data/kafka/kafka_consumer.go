@@ -4,18 +4,23 @@ import (
 	"context"
 	"fmt"
 	"github.com/segmentio/kafka-go"
+	"im-service/internal/protocol"
 	"im-service/internal/websocket/notify"
 	"log"
+	"strings"
 	"time"
 )
 
 // KafkaConsumer 定义 Kafka 消费者结构体
 type KafkaConsumer struct {
 	reader *kafka.Reader
+	router *Router
+	// local 为 true 时消费的是节点专属 topic，事件直接投递给本节点的连接
+	local bool
 }
 
-// NewKafkaConsumer 创建 Kafka 消费者实例
-func NewKafkaConsumer(brokers []string, topic string) *KafkaConsumer {
+// NewKafkaConsumer 创建主 topic 的消费者，所有网关节点共享同一个消费组，事件经路由后投递
+func NewKafkaConsumer(brokers []string, topic string, router *Router) *KafkaConsumer {
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers: brokers,
 		Topic:   topic,
@@ -23,6 +28,21 @@ func NewKafkaConsumer(brokers []string, topic string) *KafkaConsumer {
 	})
 	return &KafkaConsumer{
 		reader: reader,
+		router: router,
+	}
+}
+
+// NewNodeConsumer 创建当前网关节点专属 topic 的消费者
+func NewNodeConsumer(brokers []string, topic string, router *Router) *KafkaConsumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		Topic:   NodeTopic(topic, router.NodeID()),
+		GroupID: "gateway-" + router.NodeID(),
+	})
+	return &KafkaConsumer{
+		reader: reader,
+		router: router,
+		local:  true,
 	}
 }
 
@@ -44,12 +64,10 @@ func (c *KafkaConsumer) ConsumeMessages() error {
 			}
 		}
 
-		// 处理消息
-		log.Printf("处理消息")
-		err = HandleKafkaMessage(string(msg.Value))
+		// 处理消息，单条消息处理失败不影响后续消息
+		err = c.HandleKafkaMessage(msg.Value)
 		if err != nil {
-			log.Printf("处理消息错误")
-			return err
+			log.Printf("处理消息错误: %v", err)
 		}
 	}
 }
@@ -69,63 +87,164 @@ func (e *MyCustomError) Error() string {
 }
 
 // HandleKafkaMessage 处理 Kafka 消息
-func HandleKafkaMessage(message string) error {
+func (c *KafkaConsumer) HandleKafkaMessage(message []byte) error {
 	// 解析消息
-	parts := splitMessage(message)
-	if len(parts) < 1 {
-		err := MyCustomError{ErrMsg: "无效Kafka消息"}
+	event, err := decodeEvent(message)
+	if err != nil {
 		fmt.Printf("无效Kafka消息\n: %s\n", message)
-		return &err
+		return &MyCustomError{ErrMsg: "无效Kafka消息"}
 	}
-
-	switch parts[0] {
-	case "friend_accepted":
-		// 好友关系建立，通知相关用户
-		from := parts[1]
-		to := parts[2]
-		notify.NotifyFriendAccepted(from, to)
-	case "sendMessage":
-		// 新消息，通知相关用户
-		log.Printf("新消息，通知相关用户")
-		from := parts[1]
-		to := parts[2]
-		content := parts[3]
-		message := fmt.Sprintf("%s|%s|%s", from, to, content)
-		notify.NotifyNewMessage(message)
-	default:
-		err := MyCustomError{ErrMsg: "未知Kafka消息类型"}
-		fmt.Printf("未知Kafka消息类型: %s\n", parts[0])
-		return &err
+	// 临时事件积压过久已失去意义，直接丢弃
+	if event.Expired() {
+		return nil
 	}
-	return nil
-}
 
-// splitMessage 分割消息
-func splitMessage(message string) []string {
-	return split(message, "|")
-}
-
-// split 分割字符串
-func split(s, sep string) []string {
-	var parts []string
-	for len(s) > 0 {
-		i := index(s, sep)
-		if i < 0 {
-			parts = append(parts, s)
-			break
-		}
-		parts = append(parts, s[:i])
-		s = s[i+len(sep):]
+	if c.local {
+		return Deliver(event)
 	}
-	return parts
+	return c.router.Route(context.Background(), event)
 }
 
-// index 返回字符串中第一次出现子字符串的索引
-func index(s, sub string) int {
-	for i := 0; i <= len(s)-len(sub); i++ {
-		if s[i:i+len(sub)] == sub {
-			return i
+// Deliver 将事件推送给本节点上目标用户的连接
+func Deliver(event *Event) error {
+	switch event.Type {
+	case EventFriendAccepted:
+		// 好友关系建立，通知相关用户
+		notify.NotifyFriendAccepted(event.From, event.To, event.Targets)
+	case EventNewMessage:
+		// 新消息，通知相关用户
+		log.Printf("新消息，通知相关用户")
+		notify.NotifyNewMessage(&protocol.NewMessageEvent{
+			MessageId:         event.MessageID,
+			From:              event.From,
+			To:                event.To,
+			Type:              protocol.MessageTypeFromModel(event.MessageType),
+			Content:           event.Content,
+			Attachments:       protocol.AttachmentsFromModel(event.Attachments),
+			Location:          protocol.LocationFromModel(event.Location),
+			Timestamp:         event.Timestamp,
+			GroupId:           event.GroupID,
+			ClientMsgId:       event.ClientMsgID,
+			ConversationId:    event.ConversationID,
+			Seq:               event.Seq,
+			ExpireAt:          event.MessageExpireAt,
+			EncryptedPayloads: protocol.EncryptedPayloadsFromModel(event.EncryptedPayloads),
+		}, event.Targets)
+	case EventReceipt:
+		// 回执，通知消息发送者
+		status := protocol.ReceiptStatus_DELIVERED
+		if event.Status == ReceiptRead {
+			status = protocol.ReceiptStatus_READ
+		}
+		notify.NotifyReceipt(&protocol.ReceiptEvent{
+			From:          event.From,
+			To:            event.To,
+			Status:        status,
+			MessageIds:    event.MessageIDs,
+			UpToMessageId: event.MessageID,
+			Timestamp:     event.Timestamp,
+		}, event.Targets)
+	case EventEphemeral:
+		// 临时事件，只推送给在线连接
+		notify.NotifyEphemeral(&protocol.EphemeralEvent{
+			From:      event.From,
+			To:        event.To,
+			Kind:      protocol.EphemeralKind(protocol.EphemeralKind_value[event.Content]),
+			Timestamp: event.Timestamp,
+			GroupId:   event.GroupID,
+		}, event.Targets)
+	case EventPresence:
+		// 在线状态变化，通知好友
+		presence := &protocol.PresenceEvent{
+			Username: event.From,
+			Status:   protocol.PresenceStatus(protocol.PresenceStatus_value[strings.ToUpper(event.Content)]),
 		}
+		if presence.Status == protocol.PresenceStatus_OFFLINE {
+			presence.LastSeen = event.Timestamp
+		}
+		notify.NotifyPresence(presence, event.Targets)
+	case EventGroup:
+		// 群组成员变化，通知群成员
+		notify.NotifyGroupEvent(&protocol.GroupEvent{
+			GroupId: event.GroupID,
+			Type:    protocol.GroupEventType(protocol.GroupEventType_value[event.Content]),
+			Actor:   event.From,
+			Target:  event.To,
+			Role:    protocol.GroupRole(protocol.GroupRole_value[strings.ToUpper(event.Role)]),
+		}, event.Targets)
+	case EventRecall, EventEdit, EventDelete:
+		// 消息撤回、编辑或删除，通知会话中的用户
+		changeType := protocol.MessageChangeType_RECALLED
+		switch event.Type {
+		case EventEdit:
+			changeType = protocol.MessageChangeType_EDITED
+		case EventDelete:
+			changeType = protocol.MessageChangeType_DELETED
+		}
+		notify.NotifyMessageChanged(&protocol.MessageChangedEvent{
+			Type:           changeType,
+			Operator:       event.From,
+			MessageId:      event.MessageID,
+			ConversationId: event.ConversationID,
+			Content:        event.Content,
+			Timestamp:      event.Timestamp,
+		}, event.Targets)
+	case EventTimer:
+		// 阅后即焚时长变化，通知会话的所有成员
+		notify.NotifyDisappearingTimerChanged(&protocol.DisappearingTimerChangedEvent{
+			ConversationId: event.ConversationID,
+			Operator:       event.From,
+			Seconds:        event.DisappearingSeconds,
+			Timestamp:      event.Timestamp,
+		}, event.Targets)
+	case EventExpired:
+		// 消息过期被清理，通知会话成员删除本地副本
+		notify.NotifyMessagesExpired(&protocol.MessagesExpiredEvent{
+			ConversationId: event.ConversationID,
+			MessageIds:     event.MessageIDs,
+		}, event.Targets)
+	case EventFriendRequest:
+		// 好友请求状态变化，通知请求双方
+		notify.NotifyFriendRequest(&protocol.FriendRequestEvent{
+			RequestId: event.RequestID,
+			From:      event.From,
+			To:        event.To,
+			Status:    protocol.FriendRequestStatus(protocol.FriendRequestStatus_value[strings.ToUpper(event.Status)]),
+			Timestamp: event.Timestamp,
+		}, event.Targets)
+	case EventFriendRemoved:
+		// 好友被删除，同步到操作者的其他设备
+		notify.NotifyFriendRemoved(&protocol.FriendRemovedEvent{
+			Target: event.To,
+		}, event.Targets)
+	case EventBlock:
+		// 黑名单变化，同步到操作者的其他设备
+		notify.NotifyBlockChanged(&protocol.BlockChangedEvent{
+			Target:  event.To,
+			Blocked: event.Status == BlockBlocked,
+		}, event.Targets)
+	case EventPrekeysLow:
+		// 一次性预共享公钥不足，提醒用户的设备补充
+		notify.NotifyPrekeysLow(&protocol.PrekeysLowEvent{
+			DeviceId:         event.DeviceID,
+			RemainingPrekeys: event.RemainingPrekeys,
+		}, event.Targets)
+	case EventUnread:
+		// 未读数变化，每个用户的未读数不同，逐个推送
+		for _, target := range event.Targets {
+			unread, ok := event.Unread[target]
+			if !ok {
+				continue
+			}
+			notify.NotifyUnreadChanged(&protocol.UnreadChangedEvent{
+				ConversationId: event.ConversationID,
+				UnreadCount:    unread.Conversation,
+				TotalUnread:    unread.Total,
+			}, []string{target})
+		}
+	default:
+		fmt.Printf("未知Kafka消息类型: %s\n", event.Type)
+		return &MyCustomError{ErrMsg: "未知Kafka消息类型"}
 	}
-	return -1
+	return nil
 }
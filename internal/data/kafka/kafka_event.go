@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"encoding/json"
+	"im-service/internal/data/model"
+	"time"
+)
+
+// Kafka 事件类型
+const (
+	EventNewMessage     = "sendMessage"
+	EventFriendAccepted = "friend_accepted"
+	EventReceipt        = "receipt"
+	EventEphemeral      = "ephemeral"
+	EventPresence       = "presence"
+	EventGroup          = "group"
+	EventRecall         = "recall"
+	EventEdit           = "edit"
+	EventDelete         = "delete"
+	EventUnread         = "unread"
+	EventTimer          = "disappearing_timer"
+	EventExpired        = "expired"
+	EventPrekeysLow     = "prekeys_low"
+	EventFriendRequest  = "friend_request"
+	EventFriendRemoved  = "friend_removed"
+	EventBlock          = "block"
+)
+
+// 回执状态
+const (
+	ReceiptDelivered = "delivered"
+	ReceiptRead      = "read"
+)
+
+// 黑名单事件状态
+const (
+	BlockBlocked   = "blocked"
+	BlockUnblocked = "unblocked"
+)
+
+// Event Kafka 中传递的事件，使用 JSON 编码
+type Event struct {
+	Type      string `json:"type"`
+	MessageID string `json:"message_id,omitempty"`
+	// ClientMsgID 发送方设备生成的消息 ID
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Content     string `json:"content,omitempty"`
+	// MessageType 新消息的类型，Attachments、Location 和 EncryptedPayloads 为对应的内容
+	MessageType       string                   `json:"message_type,omitempty"`
+	Attachments       []model.Attachment       `json:"attachments,omitempty"`
+	Location          *model.Location          `json:"location,omitempty"`
+	EncryptedPayloads []model.EncryptedPayload `json:"encrypted_payloads,omitempty"`
+	// ConversationID 和 Seq 为新消息所属的会话和会话内的序列号
+	ConversationID string `json:"conversation_id,omitempty"`
+	Seq            int64  `json:"seq,omitempty"`
+	// MessageExpireAt 阅后即焚消息的到期时间，毫秒
+	MessageExpireAt int64 `json:"message_expire_at,omitempty"`
+	// DisappearingSeconds 会话新的阅后即焚时长
+	DisappearingSeconds int64 `json:"disappearing_seconds,omitempty"`
+	// GroupID 群消息和群组事件所属的群组
+	GroupID string `json:"group_id,omitempty"`
+	// Role 群组事件中目标成员的新角色
+	Role string `json:"role,omitempty"`
+	// MessageIDs 送达回执对应的消息或已过期的消息；已读回执使用 MessageID 表示已读到的消息
+	MessageIDs []string `json:"message_ids,omitempty"`
+	// Status 回执状态、好友请求的新状态，或黑名单事件中的 blocked / unblocked
+	Status string `json:"status,omitempty"`
+	// DeviceID 和 RemainingPrekeys 为一次性预共享公钥不足的设备和剩余数量
+	DeviceID         string `json:"device_id,omitempty"`
+	RemainingPrekeys int64  `json:"remaining_prekeys,omitempty"`
+	// RequestID 好友请求事件对应的请求
+	RequestID string `json:"request_id,omitempty"`
+	// Unread 未读数变化后每个目标用户在该会话中的未读数和总未读数
+	Unread map[string]UnreadCount `json:"unread,omitempty"`
+	// Timestamp 事件产生时间，毫秒
+	Timestamp int64 `json:"timestamp"`
+	// ExpiresAt 事件过期时间，毫秒，过期后不再路由和投递；为 0 表示不过期
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// Targets 需要收到推送的用户，路由时按所在节点拆分
+	Targets []string `json:"targets"`
+}
+
+// UnreadCount 用户在会话中的未读数和总未读数
+type UnreadCount struct {
+	Conversation int64 `json:"conversation"`
+	Total        int64 `json:"total"`
+}
+
+// Expired 判断事件是否已过期
+func (e *Event) Expired() bool {
+	return e.ExpiresAt != 0 && time.Now().UnixMilli() > e.ExpiresAt
+}
+
+// encodeEvent 序列化事件
+func encodeEvent(event *Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// decodeEvent 反序列化事件
+func decodeEvent(data []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
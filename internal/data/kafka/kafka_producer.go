@@ -2,49 +2,224 @@ package kafka
 
 import (
 	"context"
-	"fmt"
 	"github.com/segmentio/kafka-go"
 	"log"
+	"time"
 )
 
 // KafkaProducer 定义 Kafka 生产者结构体
 type KafkaProducer struct {
 	writer *kafka.Writer
+	topic  string
 }
 
 // NewKafkaProducer 创建 Kafka 生产者实例
 func NewKafkaProducer(brokers []string, topic string) *KafkaProducer {
+	// 不在 Writer 上固定 topic，以便向各网关节点的专属 topic 转发事件
 	writer := &kafka.Writer{
-		Addr:     kafka.TCP(brokers...),
-		Topic:    topic,
-		Balancer: &kafka.LeastBytes{},
+		Addr:                   kafka.TCP(brokers...),
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
 	}
 	return &KafkaProducer{
 		writer: writer,
+		topic:  topic,
 	}
 }
 
-// SendMessage 发送消息到 Kafka
-func (p *KafkaProducer) SendMessage(content string) error {
-	log.Printf("发送消息到 Kafka")
-	err := p.writer.WriteMessages(context.Background(),
+// SendEvent 发送事件到主 topic
+func (p *KafkaProducer) SendEvent(event *Event) error {
+	return p.sendEvent(p.topic, event)
+}
+
+// sendEvent 发送事件到指定 topic
+func (p *KafkaProducer) sendEvent(topic string, event *Event) error {
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixMilli()
+	}
+	value, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	return p.writer.WriteMessages(context.Background(),
 		kafka.Message{
-			Value: []byte(content),
+			Topic: topic,
+			Value: value,
 		},
 	)
-	return err
 }
 
 // SendFriendAcceptedNotification 发送好友关系建立通知到 Kafka
 func (p *KafkaProducer) SendFriendAcceptedNotification(from, to string) error {
 	log.Printf("发送好友关系建立通知到 Kafka")
-	notification := fmt.Sprintf("friend_accepted|%s|%s", from, to)
-	err := p.writer.WriteMessages(context.Background(),
-		kafka.Message{
-			Value: []byte(notification),
-		},
-	)
-	return err
+	return p.SendEvent(&Event{
+		Type:    EventFriendAccepted,
+		From:    from,
+		To:      to,
+		Targets: []string{from, to},
+	})
+}
+
+// SendReceipt 发送回执事件到 Kafka，from 为确认方，to 为消息发送者
+// 确认方的其他设备同样会收到，用于多端同步已读状态
+func (p *KafkaProducer) SendReceipt(from, to, status string, messageIDs []string, upToMessageID string) error {
+	return p.SendEvent(&Event{
+		Type:       EventReceipt,
+		MessageID:  upToMessageID,
+		From:       from,
+		To:         to,
+		MessageIDs: messageIDs,
+		Status:     status,
+		Targets:    []string{to, from},
+	})
+}
+
+// SendEphemeral 发送临时事件到 Kafka，只推送给 targets 当前在线的连接，超过 ttl 未投递则丢弃
+// 群聊中的临时事件 groupID 为所属群组，to 为空
+func (p *KafkaProducer) SendEphemeral(from, to, groupID, kind string, targets []string, ttl time.Duration) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	now := time.Now()
+	return p.SendEvent(&Event{
+		Type:      EventEphemeral,
+		From:      from,
+		To:        to,
+		GroupID:   groupID,
+		Content:   kind,
+		Timestamp: now.UnixMilli(),
+		ExpiresAt: now.Add(ttl).UnixMilli(),
+		Targets:   targets,
+	})
+}
+
+// SendPresenceChange 发送在线状态变化事件到 Kafka，targets 为需要收到推送的好友
+func (p *KafkaProducer) SendPresenceChange(userName, status string, targets []string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	return p.SendEvent(&Event{
+		Type:    EventPresence,
+		From:    userName,
+		Content: status,
+		Targets: targets,
+	})
+}
+
+// SendGroupEvent 发送群组成员变化事件到 Kafka，eventType 存放在 Content 中
+func (p *KafkaProducer) SendGroupEvent(groupID, eventType, actor, target, role string, targets []string) error {
+	return p.SendEvent(&Event{
+		Type:    EventGroup,
+		GroupID: groupID,
+		From:    actor,
+		To:      target,
+		Content: eventType,
+		Role:    role,
+		Targets: targets,
+	})
+}
+
+// SendMessageChange 发送消息撤回、编辑或删除事件到 Kafka，编辑事件的新内容存放在 Content 中
+func (p *KafkaProducer) SendMessageChange(eventType, operator, messageID, conversationID, content string, targets []string) error {
+	return p.SendEvent(&Event{
+		Type:           eventType,
+		MessageID:      messageID,
+		ConversationID: conversationID,
+		From:           operator,
+		Content:        content,
+		Timestamp:      time.Now().UnixMilli(),
+		Targets:        targets,
+	})
+}
+
+// SendUnreadChange 发送未读数变化事件到 Kafka，counts 的 key 为需要收到推送的用户
+func (p *KafkaProducer) SendUnreadChange(conversationID string, counts map[string]UnreadCount) error {
+	targets := make([]string, 0, len(counts))
+	for username := range counts {
+		targets = append(targets, username)
+	}
+	return p.SendEvent(&Event{
+		Type:           EventUnread,
+		ConversationID: conversationID,
+		Unread:         counts,
+		Timestamp:      time.Now().UnixMilli(),
+		Targets:        targets,
+	})
+}
+
+// SendDisappearingTimer 发送会话阅后即焚时长变化事件到 Kafka
+func (p *KafkaProducer) SendDisappearingTimer(conversationID, operator string, seconds int64, targets []string) error {
+	return p.SendEvent(&Event{
+		Type:                EventTimer,
+		ConversationID:      conversationID,
+		From:                operator,
+		DisappearingSeconds: seconds,
+		Timestamp:           time.Now().UnixMilli(),
+		Targets:             targets,
+	})
+}
+
+// SendMessagesExpired 发送消息过期事件到 Kafka
+func (p *KafkaProducer) SendMessagesExpired(conversationID string, messageIDs []string, targets []string) error {
+	return p.SendEvent(&Event{
+		Type:           EventExpired,
+		ConversationID: conversationID,
+		MessageIDs:     messageIDs,
+		Timestamp:      time.Now().UnixMilli(),
+		Targets:        targets,
+	})
+}
+
+// SendPrekeysLow 发送一次性预共享公钥不足事件到 Kafka，推送给设备所属的用户
+func (p *KafkaProducer) SendPrekeysLow(username, deviceID string, remaining int64) error {
+	return p.SendEvent(&Event{
+		Type:             EventPrekeysLow,
+		To:               username,
+		DeviceID:         deviceID,
+		RemainingPrekeys: remaining,
+		Timestamp:        time.Now().UnixMilli(),
+		Targets:          []string{username},
+	})
+}
+
+// SendFriendRequestChange 发送好友请求状态变化事件到 Kafka，推送给请求双方
+func (p *KafkaProducer) SendFriendRequestChange(requestID, from, to, status string) error {
+	return p.SendEvent(&Event{
+		Type:      EventFriendRequest,
+		RequestID: requestID,
+		From:      from,
+		To:        to,
+		Status:    status,
+		Timestamp: time.Now().UnixMilli(),
+		Targets:   []string{to, from},
+	})
+}
+
+// SendFriendRemoved 发送删除好友事件到 Kafka，只推送给操作者的其他设备，不通知对方
+func (p *KafkaProducer) SendFriendRemoved(username, target string) error {
+	return p.SendEvent(&Event{
+		Type:      EventFriendRemoved,
+		From:      username,
+		To:        target,
+		Timestamp: time.Now().UnixMilli(),
+		Targets:   []string{username},
+	})
+}
+
+// SendBlockChanged 发送黑名单变化事件到 Kafka，只推送给操作者的其他设备，不通知被拉黑的用户
+func (p *KafkaProducer) SendBlockChanged(username, target string, blocked bool) error {
+	status := BlockUnblocked
+	if blocked {
+		status = BlockBlocked
+	}
+	return p.SendEvent(&Event{
+		Type:      EventBlock,
+		From:      username,
+		To:        target,
+		Status:    status,
+		Timestamp: time.Now().UnixMilli(),
+		Targets:   []string{username},
+	})
 }
 
 // Close 关闭 Kafka 生产者
@@ -0,0 +1,113 @@
+package kafka
+
+import (
+	"context"
+	"im-service/internal/data/redis"
+	"log"
+	"time"
+)
+
+const (
+	// routeLookupAttempts 查询单个用户路由的最大尝试次数
+	routeLookupAttempts = 3
+	// routeLookupBackoff 两次查询之间的等待时间
+	routeLookupBackoff = 50 * time.Millisecond
+)
+
+// NodeTopic 返回网关节点专属的 topic，只有该节点会消费
+func NodeTopic(topic, nodeID string) string {
+	return topic + ".node." + nodeID
+}
+
+// Router 根据 Redis 中的路由表，把事件只投递到目标用户所在的网关节点
+type Router struct {
+	nodeID      string
+	topic       string
+	redisClient *redis.RedisClient
+	producer    *KafkaProducer
+	// deliver 投递本节点用户的事件，默认为 Deliver
+	deliver func(event *Event) error
+}
+
+// NewRouter 创建事件路由器，nodeID 为当前网关节点 ID
+func NewRouter(nodeID, topic string, redisClient *redis.RedisClient, producer *KafkaProducer) *Router {
+	return &Router{
+		nodeID:      nodeID,
+		topic:       topic,
+		redisClient: redisClient,
+		producer:    producer,
+		deliver:     Deliver,
+	}
+}
+
+// NodeID 返回当前网关节点 ID
+func (r *Router) NodeID() string {
+	return r.nodeID
+}
+
+// KeepAlive 定期续期当前节点的存活标记，其他节点只向存活节点转发事件
+func (r *Router) KeepAlive(ctx context.Context) {
+	ticker := time.NewTicker(redis.NodeTTL / 3)
+	defer ticker.Stop()
+	for {
+		if err := r.redisClient.KeepNodeAlive(ctx, r.nodeID); err != nil {
+			log.Printf("续期网关节点 %s 存活标记失败: %v", r.nodeID, err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// routeNodes 查询用户所在的网关节点，Redis 暂时不可用时短暂重试
+func (r *Router) routeNodes(ctx context.Context, target string) ([]string, error) {
+	var err error
+	for attempt := 0; attempt < routeLookupAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(routeLookupBackoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		var nodes []string
+		nodes, err = r.redisClient.GetRouteNodes(ctx, target)
+		if err == nil {
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// Route 按目标用户所在节点拆分事件，本节点的用户直接投递，其他节点的用户转发到对应节点的 topic
+// 某个用户的路由查询失败时只跳过该用户，其余用户照常投递，离线收件箱和增量同步负责补偿
+func (r *Router) Route(ctx context.Context, event *Event) error {
+	byNode := make(map[string][]string)
+	for _, target := range event.Targets {
+		nodes, err := r.routeNodes(ctx, target)
+		if err != nil {
+			log.Printf("查询用户 %s 的路由失败，跳过该用户: %v", target, err)
+			continue
+		}
+		for _, nodeID := range nodes {
+			byNode[nodeID] = append(byNode[nodeID], target)
+		}
+	}
+
+	for nodeID, targets := range byNode {
+		nodeEvent := *event
+		nodeEvent.Targets = targets
+		if nodeID == r.nodeID {
+			if err := r.deliver(&nodeEvent); err != nil {
+				log.Printf("投递事件失败: %v", err)
+			}
+			continue
+		}
+		if err := r.producer.sendEvent(NodeTopic(r.topic, nodeID), &nodeEvent); err != nil {
+			log.Printf("向网关节点 %s 转发事件失败: %v", nodeID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package kafka
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"im-service/internal/data/redis"
+	"slices"
+	"testing"
+)
+
+func TestRouterRouteSkipsFailedLookups(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisClient := redis.NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+	if err := redisClient.KeepNodeAlive(ctx, "node-1"); err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []string{"alice", "carol"} {
+		if err := redisClient.BindRoute(ctx, user, "conn-"+user, "node-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 路由表类型错误，查询该用户必然失败
+	if err := mr.Set("im:route:bob", "broken"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		targets []string
+		want    []string
+	}{
+		{name: "全部成功", targets: []string{"alice", "carol"}, want: []string{"alice", "carol"}},
+		{name: "跳过查询失败的用户", targets: []string{"alice", "bob", "carol"}, want: []string{"alice", "carol"}},
+		{name: "没有路由的用户", targets: []string{"dave", "alice"}, want: []string{"alice"}},
+		{name: "全部失败", targets: []string{"bob"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var delivered []string
+			router := NewRouter("node-1", "im", redisClient, nil)
+			router.deliver = func(event *Event) error {
+				delivered = append(delivered, event.Targets...)
+				return nil
+			}
+			if err := router.Route(ctx, &Event{Type: EventNewMessage, Targets: tt.targets}); err != nil {
+				t.Fatalf("Route() error = %v", err)
+			}
+			if !slices.Equal(delivered, tt.want) {
+				t.Errorf("delivered to %q, want %q", delivered, tt.want)
+			}
+		})
+	}
+}
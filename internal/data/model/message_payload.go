@@ -0,0 +1,39 @@
+package model
+
+// 消息类型，存储在消息文档中，早期消息没有该字段，视为文本
+const (
+	MessageText      = "text"
+	MessageImage     = "image"
+	MessageFile      = "file"
+	MessageVoice     = "voice"
+	MessageLocation  = "location"
+	MessageEncrypted = "encrypted"
+)
+
+// Attachment 消息引用的附件，随消息存储在 MongoDB 中，并经 Kafka 和离线收件箱下发
+type Attachment struct {
+	AttachmentID string `json:"attachment_id" bson:"attachment_id"`
+	FileName     string `json:"file_name" bson:"file_name"`
+	MimeType     string `json:"mime_type" bson:"mime_type"`
+	Size         int64  `json:"size" bson:"size"`
+	SHA256       string `json:"sha256" bson:"sha256"`
+	Width        int32  `json:"width,omitempty" bson:"width,omitempty"`
+	Height       int32  `json:"height,omitempty" bson:"height,omitempty"`
+	DurationMs   int32  `json:"duration_ms,omitempty" bson:"duration_ms,omitempty"`
+}
+
+// Location 位置消息的内容
+type Location struct {
+	Latitude  float64 `json:"latitude" bson:"latitude"`
+	Longitude float64 `json:"longitude" bson:"longitude"`
+	Name      string  `json:"name,omitempty" bson:"name,omitempty"`
+	Address   string  `json:"address,omitempty" bson:"address,omitempty"`
+}
+
+// EncryptedPayload 端到端加密消息发给某个设备的密文，服务端原样存储和下发，不解析内容
+type EncryptedPayload struct {
+	Recipient  string `json:"recipient" bson:"recipient"`
+	DeviceID   string `json:"device_id" bson:"device_id"`
+	Type       int32  `json:"type,omitempty" bson:"type,omitempty"`
+	Ciphertext []byte `json:"ciphertext" bson:"ciphertext"`
+}
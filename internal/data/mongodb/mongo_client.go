@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
@@ -38,3 +40,18 @@ func NewMongoClient(uri, database string) (*MongoClient, error) {
 func (mc *MongoClient) Close(ctx context.Context) error {
 	return mc.Client.Disconnect(ctx)
 }
+
+// CheckTransactions 检查服务器是否支持多文档事务：只有副本集成员和 mongos 支持，单机部署会返回错误
+func (mc *MongoClient) CheckTransactions(ctx context.Context) error {
+	var result struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := mc.Client.Database("admin").RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&result); err != nil {
+		return err
+	}
+	if result.SetName == "" && result.Msg != "isdbgrid" {
+		return errors.New("MongoDB 未以副本集方式部署，不支持事务；请使用 --replSet 启动并执行 rs.initiate()，URI 中加上 replicaSet 参数")
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package mysql
+
+import "time"
+
+// DeviceKey 设备的端到端加密公钥，每个用户的每个设备一条
+type DeviceKey struct {
+	ID                    int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Username              string    `gorm:"size:191;not null;uniqueIndex:idx_device_key_device" json:"username"`
+	DeviceID              string    `gorm:"size:191;not null;uniqueIndex:idx_device_key_device" json:"device_id"`
+	RegistrationID        uint32    `json:"registration_id"`
+	IdentityKey           []byte    `gorm:"not null" json:"identity_key"`
+	SignedPrekeyID        uint32    `json:"signed_prekey_id"`
+	SignedPrekey          []byte    `json:"signed_prekey"`
+	SignedPrekeySignature []byte    `json:"signed_prekey_signature"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// OneTimePrekey 设备的一次性预共享公钥，被其他用户取走后删除
+type OneTimePrekey struct {
+	ID        int64  `gorm:"primaryKey;autoIncrement" json:"id"`
+	Username  string `gorm:"size:191;not null;uniqueIndex:idx_one_time_prekey_key" json:"username"`
+	DeviceID  string `gorm:"size:191;not null;uniqueIndex:idx_one_time_prekey_key" json:"device_id"`
+	KeyID     uint32 `gorm:"not null;uniqueIndex:idx_one_time_prekey_key" json:"key_id"`
+	PublicKey []byte `gorm:"not null" json:"public_key"`
+}
@@ -25,7 +25,7 @@ func NewMySQLClient(dataSource string) (*MySQLClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = db.AutoMigrate(&User{})
+	err = db.AutoMigrate(&User{}, &DeviceKey{}, &OneTimePrekey{})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,32 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// dedupKeyPrefix 客户端消息 ID 去重，string 结构：用户 + 客户端消息 ID -> 服务端消息 ID
+const dedupKeyPrefix = "im:dedup:"
+
+// ClaimClientMessage 记录客户端消息 ID 对应的服务端消息 ID
+// 窗口内已记录过时返回之前的服务端消息 ID 和 false
+func (rc *RedisClient) ClaimClientMessage(ctx context.Context, userName, clientMsgID, messageID string, window time.Duration) (string, bool, error) {
+	key := dedupKeyPrefix + userName + ":" + clientMsgID
+	ok, err := rc.Client.SetNX(ctx, key, messageID, window).Result()
+	if err != nil {
+		return "", false, err
+	}
+	if ok {
+		return messageID, true, nil
+	}
+	existing, err := rc.Client.Get(ctx, key).Result()
+	if err != nil {
+		return "", false, err
+	}
+	return existing, false, nil
+}
+
+// ReleaseClientMessage 消息未能发送时移除去重记录，允许客户端重试
+func (rc *RedisClient) ReleaseClientMessage(ctx context.Context, userName, clientMsgID string) error {
+	return rc.Client.Del(ctx, dedupKeyPrefix+userName+":"+clientMsgID).Err()
+}
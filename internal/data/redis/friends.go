@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	// friendsKeyPrefix 用户的好友集合，key 不存在表示尚未从 MongoDB 加载
+	friendsKeyPrefix = "im:friends:"
+	// friendsPlaceholder 集合中的占位成员，使没有好友的用户也能被缓存；用户名不能为空，不会与之冲突
+	friendsPlaceholder = ""
+	// friendsGenKeyPrefix 用户好友集合的版本号，每次删除好友集合时加一；从 MongoDB 加载前读取，写入缓存时版本号不变才写入
+	friendsGenKeyPrefix = "im:friends:gen:"
+	// friendsTTL 好友集合的过期时间，好友关系变化时直接删除，过期只用于兜底
+	friendsTTL = time.Hour
+	// friendsGenTTL 版本号的过期时间，远长于一次加载的耗时，过期后重新从 0 计数也不会与加载前读到的值相同
+	friendsGenTTL = 24 * time.Hour
+)
+
+// cacheFriendsIfCurrentScript 版本号与加载前读到的相同时整体替换好友集合，返回 1；期间好友关系发生过变化时不写入，返回 0
+// KEYS[1] 好友集合，KEYS[2] 版本号；ARGV[1] 加载前的版本号，ARGV[2] 过期秒数，其余为集合成员
+const cacheFriendsIfCurrentScript = `
+if (redis.call("GET", KEYS[2]) or "0") ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+redis.call("SADD", KEYS[1], unpack(ARGV, 3))
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+return 1`
+
+// invalidateFriendsScript 删除好友集合并增加版本号，使正在从 MongoDB 加载的旧数据不能再写入
+const invalidateFriendsScript = `
+for i = 1, #KEYS, 2 do
+	redis.call("DEL", KEYS[i])
+	redis.call("INCR", KEYS[i + 1])
+	redis.call("EXPIRE", KEYS[i + 1], ARGV[1])
+end
+return 0`
+
+// isFriendIfExistsScript 集合存在时返回是否为成员（1 或 0），不存在时返回 -1
+const isFriendIfExistsScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+return redis.call("SISMEMBER", KEYS[1], ARGV[1])`
+
+// IsCachedFriend 检查 target 是否在用户的好友集合中，集合尚未加载时 ok 为 false
+func (rc *RedisClient) IsCachedFriend(ctx context.Context, username, target string) (isFriend, ok bool, err error) {
+	result, err := rc.Client.Eval(ctx, isFriendIfExistsScript, []string{friendsKeyPrefix + username}, target).Int64()
+	if err != nil {
+		return false, false, err
+	}
+	if result < 0 {
+		return false, false, nil
+	}
+	return result == 1, true, nil
+}
+
+// GetCachedFriends 返回用户的好友集合，集合尚未加载时 ok 为 false
+func (rc *RedisClient) GetCachedFriends(ctx context.Context, username string) (friends []string, ok bool, err error) {
+	members, err := rc.Client.SMembers(ctx, friendsKeyPrefix+username).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(members) == 0 {
+		return nil, false, nil
+	}
+	friends = make([]string, 0, len(members)-1)
+	for _, member := range members {
+		if member != friendsPlaceholder {
+			friends = append(friends, member)
+		}
+	}
+	return friends, true, nil
+}
+
+// FriendsGeneration 返回用户好友集合的当前版本号，从 MongoDB 加载好友前调用，写入缓存时传给 CacheFriends
+func (rc *RedisClient) FriendsGeneration(ctx context.Context, username string) (string, error) {
+	gen, err := rc.Client.Get(ctx, friendsGenKeyPrefix+username).Result()
+	if err == redis.Nil {
+		return "0", nil
+	}
+	return gen, err
+}
+
+// CacheFriends 以从 MongoDB 查询的结果整体替换用户的好友集合；gen 为加载前读到的版本号，
+// 加载期间好友关系发生变化（版本号已增加）时不写入，返回 false，避免旧数据覆盖删除后的缓存
+func (rc *RedisClient) CacheFriends(ctx context.Context, username, gen string, friends []string) (bool, error) {
+	args := make([]interface{}, 0, len(friends)+3)
+	args = append(args, gen, int(friendsTTL.Seconds()), friendsPlaceholder)
+	for _, friend := range friends {
+		args = append(args, friend)
+	}
+	written, err := rc.Client.Eval(ctx, cacheFriendsIfCurrentScript,
+		[]string{friendsKeyPrefix + username, friendsGenKeyPrefix + username}, args...).Int()
+	return written == 1, err
+}
+
+// InvalidateFriends 删除用户的好友集合并增加版本号，下次读取时从 MongoDB 重新加载
+func (rc *RedisClient) InvalidateFriends(ctx context.Context, usernames ...string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(usernames)*2)
+	for _, username := range usernames {
+		keys = append(keys, friendsKeyPrefix+username, friendsGenKeyPrefix+username)
+	}
+	return rc.Client.Eval(ctx, invalidateFriendsScript, keys, int(friendsGenTTL.Seconds())).Err()
+}
@@ -0,0 +1,188 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"im-service/internal/data/model"
+	"strconv"
+	"time"
+)
+
+const (
+	// inboxKeyPrefix 离线收件箱索引，zset 结构：消息 ID，分值为消息时间戳（毫秒）
+	inboxKeyPrefix = "im:inbox:"
+	// inboxDataKeyPrefix 离线收件箱内容，hash 结构：消息 ID -> InboxItem JSON
+	inboxDataKeyPrefix = "im:inbox:data:"
+	// inboxAckKeyPrefix 设备已确认的消息，后接 用户名:设备 ID，zset 结构：消息 ID，分值为确认时间（毫秒）
+	// 收件箱按用户保存，确认状态按设备记录，一台设备确认不影响其他设备补推
+	inboxAckKeyPrefix = "im:inbox:acked:"
+	// inboxTTL 收件箱过期时间，每次写入时刷新
+	inboxTTL = 7 * 24 * time.Hour
+	// inboxMaxSize 收件箱最多保留的条数，超出时丢弃最旧的，旧消息仍可通过历史记录查询
+	inboxMaxSize = 1000
+)
+
+// InboxItem 离线收件箱中的一条待投递消息
+type InboxItem struct {
+	MessageID      string             `json:"message_id"`
+	ConversationID string             `json:"conversation_id,omitempty"`
+	Seq            int64              `json:"seq,omitempty"`
+	From           string             `json:"from"`
+	To             string             `json:"to"`
+	Type           string             `json:"type,omitempty"`
+	Content        string             `json:"content"`
+	Attachments    []model.Attachment `json:"attachments,omitempty"`
+	Location       *model.Location    `json:"location,omitempty"`
+	Timestamp      int64              `json:"timestamp"`
+	ExpireAt       int64              `json:"expire_at,omitempty"` // 阅后即焚消息的到期时间，毫秒，到期后不再投递
+	// EncryptedPayloads 端到端加密消息中发给该用户各设备的密文
+	EncryptedPayloads []model.EncryptedPayload `json:"encrypted_payloads,omitempty"`
+}
+
+// PushInbox 将消息放入用户的离线收件箱
+func (rc *RedisClient) PushInbox(ctx context.Context, userName string, item *InboxItem) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	indexKey := inboxKeyPrefix + userName
+	dataKey := inboxDataKeyPrefix + userName
+
+	pipe := rc.Client.TxPipeline()
+	pipe.HSet(ctx, dataKey, item.MessageID, data)
+	pipe.ZAdd(ctx, indexKey, &redis.Z{Score: float64(item.Timestamp), Member: item.MessageID})
+	pipe.Expire(ctx, indexKey, inboxTTL)
+	pipe.Expire(ctx, dataKey, inboxTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	return rc.trimInbox(ctx, userName)
+}
+
+// trimInbox 收件箱超出上限时移除最旧的消息
+func (rc *RedisClient) trimInbox(ctx context.Context, userName string) error {
+	indexKey := inboxKeyPrefix + userName
+	overflow, err := rc.Client.ZRange(ctx, indexKey, 0, -inboxMaxSize-1).Result()
+	if err != nil || len(overflow) == 0 {
+		return err
+	}
+	return rc.RemoveInbox(ctx, userName, overflow...)
+}
+
+// inboxAckKey 返回设备的确认记录键
+func inboxAckKey(userName, deviceID string) string {
+	return inboxAckKeyPrefix + userName + ":" + deviceID
+}
+
+// PendingInbox 按时间顺序返回收件箱中该设备尚未确认的消息 ID
+func (rc *RedisClient) PendingInbox(ctx context.Context, userName, deviceID string) ([]string, error) {
+	ids, err := rc.Client.ZRange(ctx, inboxKeyPrefix+userName, 0, -1).Result()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	acked, err := rc.Client.ZMScore(ctx, inboxAckKey(userName, deviceID), ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+	pending := ids[:0]
+	for i, id := range ids {
+		// 未确认的成员分值为 0
+		if acked[i] == 0 {
+			pending = append(pending, id)
+		}
+	}
+	return pending, nil
+}
+
+// GetInboxItems 按给定顺序返回收件箱中的消息内容，已被移除的消息会被跳过
+func (rc *RedisClient) GetInboxItems(ctx context.Context, userName string, messageIDs []string) ([]*InboxItem, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	values, err := rc.Client.HMGet(ctx, inboxDataKeyPrefix+userName, messageIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*InboxItem, 0, len(values))
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			// 索引存在但内容已丢失，跳过
+			continue
+		}
+		var item InboxItem
+		if err := json.Unmarshal([]byte(data), &item); err != nil {
+			continue
+		}
+		items = append(items, &item)
+	}
+	return items, nil
+}
+
+// AckInbox 记录设备已确认收到的消息，之后不再向该设备补推；用户的其他设备不受影响
+// 收件箱中的消息本身按上限和过期时间清理，确认记录只保留与收件箱相同的时长
+func (rc *RedisClient) AckInbox(ctx context.Context, userName, deviceID string, messageIDs ...string) error {
+	if len(messageIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	members := make([]*redis.Z, len(messageIDs))
+	for i, id := range messageIDs {
+		members[i] = &redis.Z{Score: float64(now.UnixMilli()), Member: id}
+	}
+	key := inboxAckKey(userName, deviceID)
+	pipe := rc.Client.TxPipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-inboxTTL).UnixMilli(), 10))
+	pipe.Expire(ctx, key, inboxTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemoveInbox 从收件箱中移除消息，所有设备都不再补推，用于撤回、删除和过期的消息
+func (rc *RedisClient) RemoveInbox(ctx context.Context, userName string, messageIDs ...string) error {
+	if len(messageIDs) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(messageIDs))
+	for i, id := range messageIDs {
+		members[i] = id
+	}
+	pipe := rc.Client.TxPipeline()
+	pipe.ZRem(ctx, inboxKeyPrefix+userName, members...)
+	pipe.HDel(ctx, inboxDataKeyPrefix+userName, messageIDs...)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// hsetIfExistsScript 只在字段存在时覆盖
+const hsetIfExistsScript = `
+if redis.call("HEXISTS", KEYS[1], ARGV[1]) == 1 then
+	return redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+end
+return 0`
+
+// UpdateInboxContent 修改收件箱中尚未投递的消息内容，消息不在收件箱中时忽略
+func (rc *RedisClient) UpdateInboxContent(ctx context.Context, userName, messageID, content string) error {
+	dataKey := inboxDataKeyPrefix + userName
+	data, err := rc.Client.HGet(ctx, dataKey, messageID).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var item InboxItem
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		return err
+	}
+	item.Content = content
+	updated, err := json.Marshal(&item)
+	if err != nil {
+		return err
+	}
+	// 读取后消息可能已被确认移除，只在仍存在时写回
+	return rc.Client.Eval(ctx, hsetIfExistsScript, []string{dataKey}, messageID, updated).Err()
+}
@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"slices"
+	"testing"
+)
+
+func TestInboxAckPerDevice(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+	for i, id := range []string{"m1", "m2", "m3"} {
+		if err := rc.PushInbox(ctx, "alice", &InboxItem{MessageID: id, From: "bob", To: "alice", Content: id, Timestamp: int64(i + 1)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rc.AckInbox(ctx, "alice", "phone", "m1", "m3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rc.RemoveInbox(ctx, "alice", "m2"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		device string
+		want   []string
+	}{
+		{device: "phone", want: nil},
+		// 手机的确认不影响电脑，撤回等移除对所有设备生效
+		{device: "laptop", want: []string{"m1", "m3"}},
+	}
+	for _, tt := range tests {
+		got, err := rc.PendingInbox(ctx, "alice", tt.device)
+		if err != nil {
+			t.Fatalf("PendingInbox(%s) error = %v", tt.device, err)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && !slices.Equal(got, tt.want)) {
+			t.Errorf("PendingInbox(%s) = %q, want %q", tt.device, got, tt.want)
+		}
+	}
+
+	items, err := rc.GetInboxItems(ctx, "alice", []string{"m3", "m2", "m1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var contents []string
+	for _, item := range items {
+		contents = append(contents, item.Content)
+	}
+	if !slices.Equal(contents, []string{"m3", "m1"}) {
+		t.Errorf("GetInboxItems() = %q, want [m3 m1]", contents)
+	}
+}
+
+func TestInboxTrim(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+	for i := 0; i < inboxMaxSize+5; i++ {
+		if err := rc.PushInbox(ctx, "alice", &InboxItem{MessageID: fmt.Sprintf("m%d", i), Timestamp: int64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending, err := rc.PendingInbox(ctx, "alice", "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != inboxMaxSize || pending[0] != "m5" {
+		t.Errorf("PendingInbox() returned %d items starting at %s, want %d starting at m5", len(pending), pending[0], inboxMaxSize)
+	}
+}
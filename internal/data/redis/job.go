@@ -0,0 +1,73 @@
+package redis
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// jobKeyPrefix 定时任务的领取标记，值为领取者标识
+const jobKeyPrefix = "im:job:"
+
+// renewJobScript 只有领取标记仍属于自己时才延长有效期
+const renewJobScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`
+
+// ClaimJob 领取名为 name 的定时任务，ttl 内只有一个节点能领取成功，用于多节点部署时避免重复执行
+func (rc *RedisClient) ClaimJob(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	return rc.Client.SetNX(ctx, jobKeyPrefix+name, owner, ttl).Result()
+}
+
+// RenewJob 延长已领取任务的有效期，标记已过期或被其他节点领取时返回 false
+func (rc *RedisClient) RenewJob(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	result, err := rc.Client.Eval(ctx, renewJobScript, []string{jobKeyPrefix + name}, owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return result == 1, nil
+}
+
+// HoldJob 在任务执行期间定期续约领取标记，标记被其他节点领取或超过 ttl 未能续约时取消返回的 context，
+// 调用方应停止执行；执行完毕后调用 release 停止续约，标记保留到 ttl 到期，同一周期内其他节点不会重复执行
+func (rc *RedisClient) HoldJob(ctx context.Context, name, owner string, ttl time.Duration) (jobCtx context.Context, release func()) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer cancel()
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		renewedAt := time.Now()
+		for {
+			select {
+			case <-done:
+				return
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			start := time.Now()
+			ok, err := rc.RenewJob(jobCtx, name, owner, ttl)
+			if err != nil {
+				// Redis 暂时不可用时继续执行，超过 ttl 后标记可能已被其他节点领取
+				log.Printf("续约任务 %s 失败: %v", name, err)
+				if time.Since(renewedAt) >= ttl {
+					return
+				}
+				continue
+			}
+			if !ok {
+				log.Printf("任务 %s 的领取标记已丢失，停止执行", name)
+				return
+			}
+			renewedAt = start
+		}
+	}()
+	var once sync.Once
+	return jobCtx, func() {
+		once.Do(func() { close(done) })
+	}
+}
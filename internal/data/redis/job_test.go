@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"testing"
+	"time"
+)
+
+func TestRenewJob(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	if ok, err := rc.ClaimJob(ctx, "purge", "node-1", time.Minute); err != nil || !ok {
+		t.Fatalf("ClaimJob() = %v, %v, want true", ok, err)
+	}
+	if ok, err := rc.ClaimJob(ctx, "purge", "node-2", time.Minute); err != nil || ok {
+		t.Fatalf("重复 ClaimJob() = %v, %v, want false", ok, err)
+	}
+
+	mr.FastForward(50 * time.Second)
+	if ok, err := rc.RenewJob(ctx, "purge", "node-1", time.Minute); err != nil || !ok {
+		t.Fatalf("RenewJob() = %v, %v, want true", ok, err)
+	}
+	if ttl := mr.TTL(jobKeyPrefix + "purge"); ttl != time.Minute {
+		t.Fatalf("续约后有效期 = %v, want %v", ttl, time.Minute)
+	}
+	if ok, err := rc.RenewJob(ctx, "purge", "node-2", time.Minute); err != nil || ok {
+		t.Fatalf("其他节点 RenewJob() = %v, %v, want false", ok, err)
+	}
+
+	mr.FastForward(time.Minute)
+	if ok, err := rc.RenewJob(ctx, "purge", "node-1", time.Minute); err != nil || ok {
+		t.Fatalf("过期后 RenewJob() = %v, %v, want false", ok, err)
+	}
+}
+
+func TestHoldJob(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+	ttl := 150 * time.Millisecond
+
+	if ok, err := rc.ClaimJob(ctx, "suggest", "node-1", ttl); err != nil || !ok {
+		t.Fatalf("ClaimJob() = %v, %v, want true", ok, err)
+	}
+	jobCtx, release := rc.HoldJob(ctx, "suggest", "node-1", ttl)
+	defer release()
+
+	// 持有期间标记一直属于自己
+	time.Sleep(2 * ttl)
+	if jobCtx.Err() != nil {
+		t.Fatalf("持有期间 context 被取消: %v", jobCtx.Err())
+	}
+	if owner, _ := mr.Get(jobKeyPrefix + "suggest"); owner != "node-1" {
+		t.Fatalf("领取者 = %q, want node-1", owner)
+	}
+
+	// 标记被其他节点领取后停止执行
+	mr.Set(jobKeyPrefix+"suggest", "node-2")
+	select {
+	case <-jobCtx.Done():
+	case <-time.After(2 * ttl):
+		t.Fatal("标记丢失后 context 没有取消")
+	}
+}
+
+func TestHoldJobRelease(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	if _, err := rc.ClaimJob(ctx, "purge", "node-1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	jobCtx, release := rc.HoldJob(ctx, "purge", "node-1", time.Minute)
+	release()
+	release()
+	select {
+	case <-jobCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("release 后 context 没有取消")
+	}
+	// 标记保留到有效期结束
+	if !mr.Exists(jobKeyPrefix + "purge") {
+		t.Fatal("release 不应删除领取标记")
+	}
+}
@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// prekeysLowKeyPrefix 设备的一次性预共享公钥不足提醒已发送的标记，设备补充到阈值以上时清除
+	prekeysLowKeyPrefix = "im:prekeylow:"
+	// prekeysLowTTL 提醒标记的有效期，设备长期不补充时重新提醒
+	prekeysLowTTL = 7 * 24 * time.Hour
+)
+
+// MarkPrekeysLow 记录设备的一次性预共享公钥不足，本次低于阈值后第一次调用返回 true，调用方据此只提醒一次
+func (rc *RedisClient) MarkPrekeysLow(ctx context.Context, username, deviceID string) (bool, error) {
+	return rc.Client.SetNX(ctx, prekeysLowKeyPrefix+username+":"+deviceID, 1, prekeysLowTTL).Result()
+}
+
+// ClearPrekeysLow 设备补充一次性预共享公钥后清除提醒标记，之后再次低于阈值时重新提醒
+func (rc *RedisClient) ClearPrekeysLow(ctx context.Context, username, deviceID string) error {
+	return rc.Client.Del(ctx, prekeysLowKeyPrefix+username+":"+deviceID).Err()
+}
@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"testing"
+)
+
+func TestMarkPrekeysLow(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	// 按顺序执行：低于阈值只提醒一次，补充后再次低于阈值重新提醒
+	steps := []struct {
+		name  string
+		clear bool
+		want  bool
+	}{
+		{name: "第一次低于阈值", want: true},
+		{name: "再次被取走", want: false},
+		{name: "仍低于阈值", want: false},
+		{name: "补充后再次低于阈值", clear: true, want: true},
+		{name: "之后不再重复提醒", want: false},
+	}
+	for _, step := range steps {
+		if step.clear {
+			if err := rc.ClearPrekeysLow(ctx, "alice", "phone"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		got, err := rc.MarkPrekeysLow(ctx, "alice", "phone")
+		if err != nil {
+			t.Fatalf("%s: MarkPrekeysLow() error = %v", step.name, err)
+		}
+		if got != step.want {
+			t.Fatalf("%s: MarkPrekeysLow() = %v, want %v", step.name, got, step.want)
+		}
+	}
+
+	// 不同设备各自提醒
+	if got, err := rc.MarkPrekeysLow(ctx, "alice", "laptop"); err != nil || !got {
+		t.Fatalf("其他设备 MarkPrekeysLow() = %v, %v, want true", got, err)
+	}
+}
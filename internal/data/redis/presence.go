@@ -0,0 +1,162 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+const (
+	// presenceKeyPrefix 用户各设备的在线状态，hash 结构：设备 ID -> DevicePresence JSON
+	presenceKeyPrefix = "im:presence:"
+	// lastSeenKeyPrefix 用户最后在线时间，毫秒
+	lastSeenKeyPrefix = "im:lastseen:"
+	// presenceHiddenKey 隐藏在线状态的用户集合
+	presenceHiddenKey = "im:presence:hidden"
+	// presenceTTL 设备状态整体过期时间，每次更新时刷新，用于清理异常残留
+	presenceTTL = 24 * time.Hour
+)
+
+// 在线状态
+const (
+	PresenceOffline = "offline"
+	PresenceOnline  = "online"
+	PresenceAway    = "away"
+)
+
+// DevicePresence 单个设备的在线状态
+type DevicePresence struct {
+	Status    string `json:"status"`
+	NodeID    string `json:"node_id"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// setDevicePresenceScript 更新设备状态并记录最后在线时间，同时返回更新前后的聚合状态，
+// 并发的状态更新各自看到的前后状态首尾相接，好友不会漏掉或重复收到变化
+// 所在节点已下线的设备不参与聚合，与 GetPresence 的规则一致
+// KEYS[1] 设备状态 hash，KEYS[2] 最后在线时间；ARGV[1] 设备 ID，ARGV[2] 设备状态 JSON（离线时为空），
+// ARGV[3] 当前时间毫秒，ARGV[4] 节点存活标记前缀，ARGV[5] 过期秒数
+const setDevicePresenceScript = `
+local function aggregate()
+	local values = redis.call("HGETALL", KEYS[1])
+	local status = "offline"
+	for i = 2, #values, 2 do
+		local ok, device = pcall(cjson.decode, values[i])
+		if ok and type(device) == "table" and redis.call("EXISTS", ARGV[4] .. tostring(device.node_id)) == 1 then
+			if device.status == "online" then
+				return "online"
+			end
+			if device.status == "away" then
+				status = "away"
+			end
+		end
+	end
+	return status
+end
+local before = aggregate()
+if ARGV[2] == "" then
+	redis.call("HDEL", KEYS[1], ARGV[1])
+else
+	redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+	redis.call("EXPIRE", KEYS[1], ARGV[5])
+end
+redis.call("SET", KEYS[2], ARGV[3])
+return {before, aggregate()}`
+
+// SetDevicePresence 更新设备的在线状态，状态为离线时移除该设备，并记录最后在线时间
+// 在一个脚本中完成，返回更新前后用户的聚合状态
+func (rc *RedisClient) SetDevicePresence(ctx context.Context, userName, deviceID, nodeID, status string) (before, after string, err error) {
+	now := time.Now().UnixMilli()
+	var data []byte
+	if status != PresenceOffline {
+		data, err = json.Marshal(&DevicePresence{Status: status, NodeID: nodeID, UpdatedAt: now})
+		if err != nil {
+			return PresenceOffline, PresenceOffline, err
+		}
+	}
+	result, err := rc.Client.Eval(ctx, setDevicePresenceScript,
+		[]string{presenceKeyPrefix + userName, lastSeenKeyPrefix + userName},
+		deviceID, string(data), now, nodeKeyPrefix, int64(presenceTTL/time.Second),
+	).StringSlice()
+	if err != nil {
+		return PresenceOffline, PresenceOffline, err
+	}
+	if len(result) != 2 {
+		return PresenceOffline, PresenceOffline, fmt.Errorf("在线状态脚本返回了 %d 个值", len(result))
+	}
+	return result[0], result[1], nil
+}
+
+// GetDevicePresences 返回用户各设备的在线状态，所在节点已下线的设备会被忽略
+func (rc *RedisClient) GetDevicePresences(ctx context.Context, userName string) (map[string]*DevicePresence, error) {
+	values, err := rc.Client.HGetAll(ctx, presenceKeyPrefix+userName).Result()
+	if err != nil {
+		return nil, err
+	}
+	devices := make(map[string]*DevicePresence, len(values))
+	var nodes []string
+	for deviceID, value := range values {
+		var device DevicePresence
+		if err := json.Unmarshal([]byte(value), &device); err != nil {
+			continue
+		}
+		devices[deviceID] = &device
+		nodes = append(nodes, device.NodeID)
+	}
+	alive, err := rc.aliveNodes(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+	for deviceID, device := range devices {
+		if !alive[device.NodeID] {
+			delete(devices, deviceID)
+		}
+	}
+	return devices, nil
+}
+
+// GetPresence 返回用户的聚合在线状态：任一设备在线即为在线，否则任一设备离开即为离开
+func (rc *RedisClient) GetPresence(ctx context.Context, userName string) (string, error) {
+	devices, err := rc.GetDevicePresences(ctx, userName)
+	if err != nil {
+		return PresenceOffline, err
+	}
+	status := PresenceOffline
+	for _, device := range devices {
+		switch device.Status {
+		case PresenceOnline:
+			return PresenceOnline, nil
+		case PresenceAway:
+			status = PresenceAway
+		}
+	}
+	return status, nil
+}
+
+// GetLastSeen 返回用户最后在线时间，毫秒，未知时为 0
+func (rc *RedisClient) GetLastSeen(ctx context.Context, userName string) (int64, error) {
+	value, err := rc.Client.Get(ctx, lastSeenKeyPrefix+userName).Result()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// SetPresenceHidden 隐藏或公开用户的在线状态
+func (rc *RedisClient) SetPresenceHidden(ctx context.Context, userName string, hidden bool) error {
+	if hidden {
+		return rc.Client.SAdd(ctx, presenceHiddenKey, userName).Err()
+	}
+	return rc.Client.SRem(ctx, presenceHiddenKey, userName).Err()
+}
+
+// IsPresenceHidden 判断用户是否隐藏了在线状态
+func (rc *RedisClient) IsPresenceHidden(ctx context.Context, userName string) (bool, error) {
+	return rc.Client.SIsMember(ctx, presenceHiddenKey, userName).Result()
+}
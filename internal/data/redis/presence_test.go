@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"testing"
+)
+
+func TestSetDevicePresence(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+	for _, nodeID := range []string{"node-1", "node-2"} {
+		if err := rc.KeepNodeAlive(ctx, nodeID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 按顺序执行，每一步的前后状态接着上一步
+	steps := []struct {
+		name       string
+		device     string
+		nodeID     string
+		status     string
+		wantBefore string
+		wantAfter  string
+	}{
+		{name: "第一台设备上线", device: "d1", nodeID: "node-1", status: PresenceOnline, wantBefore: PresenceOffline, wantAfter: PresenceOnline},
+		{name: "第二台设备离开", device: "d2", nodeID: "node-2", status: PresenceAway, wantBefore: PresenceOnline, wantAfter: PresenceOnline},
+		{name: "在线设备离开", device: "d1", nodeID: "node-1", status: PresenceAway, wantBefore: PresenceOnline, wantAfter: PresenceAway},
+		{name: "一台设备下线", device: "d1", nodeID: "node-1", status: PresenceOffline, wantBefore: PresenceAway, wantAfter: PresenceAway},
+		{name: "最后一台设备下线", device: "d2", nodeID: "node-2", status: PresenceOffline, wantBefore: PresenceAway, wantAfter: PresenceOffline},
+		{name: "节点已下线的设备不计入", device: "d3", nodeID: "node-dead", status: PresenceOnline, wantBefore: PresenceOffline, wantAfter: PresenceOffline},
+	}
+	for _, step := range steps {
+		before, after, err := rc.SetDevicePresence(ctx, "alice", step.device, step.nodeID, step.status)
+		if err != nil {
+			t.Fatalf("%s: SetDevicePresence() error = %v", step.name, err)
+		}
+		if before != step.wantBefore || after != step.wantAfter {
+			t.Errorf("%s: SetDevicePresence() = %s -> %s, want %s -> %s", step.name, before, after, step.wantBefore, step.wantAfter)
+		}
+		if status, err := rc.GetPresence(ctx, "alice"); err != nil || status != after {
+			t.Errorf("%s: GetPresence() = %s, %v, want %s", step.name, status, err, after)
+		}
+	}
+	if lastSeen, err := rc.GetLastSeen(ctx, "alice"); err != nil || lastSeen == 0 {
+		t.Errorf("GetLastSeen() = %d, %v, want a timestamp", lastSeen, err)
+	}
+}
@@ -0,0 +1,28 @@
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// rateLimitKeyPrefix 限流计数，string 结构，按固定窗口计数
+const rateLimitKeyPrefix = "im:ratelimit:"
+
+// allowRateScript 计数加一，窗口内第一次计数或计数没有过期时间时设置过期时间，返回当前计数
+// 计数和过期时间在一个脚本中设置，进程在两者之间退出也不会留下永不过期的计数
+// KEYS[1] 计数 key；ARGV[1] 窗口毫秒数
+const allowRateScript = `
+local count = redis.call("INCR", KEYS[1])
+if count == 1 or redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count`
+
+// AllowRate 固定窗口限流，窗口内对同一个 key 最多允许 limit 次，超出返回 false
+func (rc *RedisClient) AllowRate(ctx context.Context, key string, limit int64, window time.Duration) (bool, error) {
+	count, err := rc.Client.Eval(ctx, allowRateScript, []string{rateLimitKeyPrefix + key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return count <= limit, nil
+}
@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"testing"
+	"time"
+)
+
+func TestAllowRate(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	for i := 1; i <= 4; i++ {
+		allowed, err := rc.AllowRate(ctx, "alice", 3, time.Second)
+		if err != nil {
+			t.Fatalf("AllowRate() error = %v", err)
+		}
+		if want := i <= 3; allowed != want {
+			t.Fatalf("第 %d 次 AllowRate() = %v, want %v", i, allowed, want)
+		}
+	}
+	if ttl := mr.TTL(rateLimitKeyPrefix + "alice"); ttl <= 0 || ttl > time.Second {
+		t.Fatalf("计数过期时间 = %v, want (0, 1s]", ttl)
+	}
+
+	// 窗口结束后重新计数
+	mr.FastForward(time.Second)
+	allowed, err := rc.AllowRate(ctx, "alice", 3, time.Second)
+	if err != nil || !allowed {
+		t.Fatalf("新窗口 AllowRate() = %v, %v, want true", allowed, err)
+	}
+}
+
+func TestAllowRateRepairsMissingExpiry(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	// 模拟旧版本在 INCR 和 EXPIRE 之间退出留下的没有过期时间的计数
+	if err := mr.Set(rateLimitKeyPrefix+"bob", "10"); err != nil {
+		t.Fatal(err)
+	}
+	allowed, err := rc.AllowRate(ctx, "bob", 3, time.Second)
+	if err != nil {
+		t.Fatalf("AllowRate() error = %v", err)
+	}
+	if allowed {
+		t.Fatal("超出限制的计数不应放行")
+	}
+	if ttl := mr.TTL(rateLimitKeyPrefix + "bob"); ttl <= 0 {
+		t.Fatalf("计数过期时间 = %v, 应补上过期时间", ttl)
+	}
+}
@@ -32,3 +32,8 @@ func (rc *RedisClient) CheckAndSetIdempotency(requestID string, expiration time.
 	}
 	return set, nil
 }
+
+// ReleaseIdempotency 删除幂等性键，请求处理失败时允许重试
+func (rc *RedisClient) ReleaseIdempotency(requestID string) error {
+	return rc.Client.Del(context.Background(), requestID).Err()
+}
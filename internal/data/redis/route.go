@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	// routeKeyPrefix 用户路由表，hash 结构：连接 ID -> 网关节点 ID
+	routeKeyPrefix = "im:route:"
+	// nodeKeyPrefix 网关节点存活标记
+	nodeKeyPrefix = "im:node:"
+	// routeTTL 路由表整体过期时间，每次有新连接绑定时刷新，用于清理异常残留
+	routeTTL = 24 * time.Hour
+	// NodeTTL 节点存活标记过期时间，节点需要在此时间内续期
+	NodeTTL = 30 * time.Second
+)
+
+// BindRoute 记录用户的一个连接位于哪个网关节点
+func (rc *RedisClient) BindRoute(ctx context.Context, userName, connID, nodeID string) error {
+	key := routeKeyPrefix + userName
+	pipe := rc.Client.TxPipeline()
+	pipe.HSet(ctx, key, connID, nodeID)
+	pipe.Expire(ctx, key, routeTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UnbindRoute 连接断开时移除路由
+func (rc *RedisClient) UnbindRoute(ctx context.Context, userName, connID string) error {
+	return rc.Client.HDel(ctx, routeKeyPrefix+userName, connID).Err()
+}
+
+// GetRouteNodes 返回用户当前有连接的存活网关节点，节点已下线的路由会被忽略
+func (rc *RedisClient) GetRouteNodes(ctx context.Context, userName string) ([]string, error) {
+	routes, err := rc.Client.HGetAll(ctx, routeKeyPrefix+userName).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) == 0 {
+		return nil, nil
+	}
+
+	nodes := make([]string, 0, len(routes))
+	for _, nodeID := range routes {
+		nodes = append(nodes, nodeID)
+	}
+	aliveNodes, err := rc.aliveNodes(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+	var alive []string
+	for nodeID := range aliveNodes {
+		alive = append(alive, nodeID)
+	}
+	return alive, nil
+}
+
+// aliveNodes 去重后批量检查节点是否存活，返回存活的节点
+func (rc *RedisClient) aliveNodes(ctx context.Context, nodes []string) (map[string]bool, error) {
+	var unique []string
+	seen := make(map[string]bool)
+	for _, nodeID := range nodes {
+		if !seen[nodeID] {
+			seen[nodeID] = true
+			unique = append(unique, nodeID)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, nil
+	}
+	pipe := rc.Client.Pipeline()
+	cmds := make([]*redis.IntCmd, len(unique))
+	for i, nodeID := range unique {
+		cmds[i] = pipe.Exists(ctx, nodeKeyPrefix+nodeID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	alive := make(map[string]bool)
+	for i, cmd := range cmds {
+		if cmd.Val() > 0 {
+			alive[unique[i]] = true
+		}
+	}
+	return alive, nil
+}
+
+// IsOnline 判断用户是否在任一存活的网关节点上有连接
+func (rc *RedisClient) IsOnline(ctx context.Context, userName string) (bool, error) {
+	nodes, err := rc.GetRouteNodes(ctx, userName)
+	if err != nil {
+		return false, err
+	}
+	return len(nodes) > 0, nil
+}
+
+// KeepNodeAlive 续期网关节点存活标记
+func (rc *RedisClient) KeepNodeAlive(ctx context.Context, nodeID string) error {
+	return rc.Client.Set(ctx, nodeKeyPrefix+nodeID, time.Now().Unix(), NodeTTL).Err()
+}
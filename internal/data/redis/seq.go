@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+)
+
+// seqKeyPrefix 会话的序列号计数器，string 结构：会话 ID -> 最近分配的序列号，不设置过期时间
+const seqKeyPrefix = "im:seq:"
+
+// incrSeqIfExistsScript 计数器存在时递增，不存在时返回 0，由调用方从持久化数据中恢复
+const incrSeqIfExistsScript = `
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCR", KEYS[1])
+end
+return 0`
+
+// initSeqScript 递增计数器，结果不大于 floor 时从 floor + 1 开始
+const initSeqScript = `
+local seq = redis.call("INCR", KEYS[1])
+local floor = tonumber(ARGV[1])
+if seq <= floor then
+	seq = floor + 1
+	redis.call("SET", KEYS[1], seq)
+end
+return seq`
+
+// NextSeq 为会话分配下一个序列号，计数器不存在时返回 0
+func (rc *RedisClient) NextSeq(ctx context.Context, conversationID string) (int64, error) {
+	return rc.Client.Eval(ctx, incrSeqIfExistsScript, []string{seqKeyPrefix + conversationID}).Int64()
+}
+
+// InitSeq 计数器丢失后根据已持久化的最大序列号 floor 恢复，并分配下一个序列号
+func (rc *RedisClient) InitSeq(ctx context.Context, conversationID string, floor int64) (int64, error) {
+	return rc.Client.Eval(ctx, initSeqScript, []string{seqKeyPrefix + conversationID}, floor).Int64()
+}
+
+// GetSeq 返回会话最近分配的序列号，尚未分配过时返回 0
+func (rc *RedisClient) GetSeq(ctx context.Context, conversationID string) (int64, error) {
+	seq, err := rc.Client.Get(ctx, seqKeyPrefix+conversationID).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return seq, err
+}
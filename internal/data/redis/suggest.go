@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	// suggestKeyPrefix 用户的好友推荐，值为 FriendSuggestion 列表的 JSON，由定时任务预先计算
+	suggestKeyPrefix = "im:suggest:"
+	// suggestQueueKey 等待计算好友推荐的用户，set 结构，同一用户只排队一次
+	suggestQueueKey = "im:suggestqueue"
+)
+
+// FriendSuggestion 推荐的好友
+type FriendSuggestion struct {
+	Username      string   `json:"username"`
+	MutualCount   int      `json:"mutual_count"`
+	MutualFriends []string `json:"mutual_friends"` // 最多保存部分共同好友
+	SharedGroups  int      `json:"shared_groups"`
+}
+
+// SetFriendSuggestions 保存用户的好友推荐
+func (rc *RedisClient) SetFriendSuggestions(ctx context.Context, username string, suggestions []*FriendSuggestion, ttl time.Duration) error {
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return err
+	}
+	return rc.Client.Set(ctx, suggestKeyPrefix+username, data, ttl).Err()
+}
+
+// GetFriendSuggestions 返回用户的好友推荐，尚未计算时 ok 为 false
+func (rc *RedisClient) GetFriendSuggestions(ctx context.Context, username string) (suggestions []*FriendSuggestion, ok bool, err error) {
+	data, err := rc.Client.Get(ctx, suggestKeyPrefix+username).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := json.Unmarshal(data, &suggestions); err != nil {
+		return nil, false, err
+	}
+	return suggestions, true, nil
+}
+
+// QueueFriendSuggestions 将用户加入好友推荐的计算队列
+func (rc *RedisClient) QueueFriendSuggestions(ctx context.Context, username string) error {
+	return rc.Client.SAdd(ctx, suggestQueueKey, username).Err()
+}
+
+// PopFriendSuggestionQueue 从计算队列中取出最多 count 个用户，多个节点同时取时不会取到同一个用户
+func (rc *RedisClient) PopFriendSuggestionQueue(ctx context.Context, count int64) ([]string, error) {
+	return rc.Client.SPopN(ctx, suggestQueueKey, count).Result()
+}
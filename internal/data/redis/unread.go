@@ -0,0 +1,122 @@
+package redis
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+const (
+	// unreadKeyPrefix 用户的未读计数，hash 结构：会话 ID -> 未读数，total 字段为总未读数，不设置过期时间
+	// 未读数为 0 的会话不保存；key 不存在表示尚未从 MongoDB 重建
+	unreadKeyPrefix = "im:unread:"
+	// unreadTotalField 总未读数字段，会话 ID 均带有前缀，不会与之冲突
+	unreadTotalField = "total"
+	// unreadCheckedKeyPrefix 用户的未读计数最近一次与 MongoDB 核对的标记，过期后需要重新核对
+	unreadCheckedKeyPrefix = "im:unreadcheck:"
+)
+
+// incrUnreadIfExistsScript 计数存在时会话未读数和总未读数各加一，返回 {会话未读数, 总未读数}；不存在时返回空，由调用方重建
+const incrUnreadIfExistsScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return nil
+end
+local count = redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+local total = redis.call("HINCRBY", KEYS[1], "total", 1)
+return {count, total}`
+
+// setUnreadIfExistsScript 计数存在时设置会话未读数并同步调整总未读数，返回 {会话未读数, 总未读数}；不存在时返回空
+const setUnreadIfExistsScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return nil
+end
+local count = tonumber(ARGV[2])
+local old = tonumber(redis.call("HGET", KEYS[1], ARGV[1]) or "0")
+if count > 0 then
+	redis.call("HSET", KEYS[1], ARGV[1], count)
+else
+	redis.call("HDEL", KEYS[1], ARGV[1])
+end
+local total = redis.call("HINCRBY", KEYS[1], "total", count - old)
+return {count, total}`
+
+// UnreadCount 会话未读数和变化后的总未读数
+type UnreadCount struct {
+	Conversation int64
+	Total        int64
+}
+
+// unreadResult 解析脚本返回的 {会话未读数, 总未读数}，计数不存在时返回 nil
+func unreadResult(result interface{}, err error) (*UnreadCount, error) {
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return nil, nil
+	}
+	conversation, _ := values[0].(int64)
+	total, _ := values[1].(int64)
+	return &UnreadCount{Conversation: conversation, Total: total}, nil
+}
+
+// IncrUnread 用户在会话中的未读数加一，计数尚未重建时返回 nil
+func (rc *RedisClient) IncrUnread(ctx context.Context, username, conversationID string) (*UnreadCount, error) {
+	return unreadResult(rc.Client.Eval(ctx, incrUnreadIfExistsScript, []string{unreadKeyPrefix + username}, conversationID).Result())
+}
+
+// SetUnread 设置用户在会话中的未读数，计数尚未重建时返回 nil
+func (rc *RedisClient) SetUnread(ctx context.Context, username, conversationID string, count int64) (*UnreadCount, error) {
+	return unreadResult(rc.Client.Eval(ctx, setUnreadIfExistsScript, []string{unreadKeyPrefix + username}, conversationID, count).Result())
+}
+
+// ReplaceUnread 以从 MongoDB 统计的结果整体替换用户的未读计数，返回总未读数
+func (rc *RedisClient) ReplaceUnread(ctx context.Context, username string, counts map[string]int64) (int64, error) {
+	key := unreadKeyPrefix + username
+	var total int64
+	values := map[string]interface{}{}
+	for conversationID, count := range counts {
+		if count > 0 {
+			values[conversationID] = count
+			total += count
+		}
+	}
+	values[unreadTotalField] = total
+
+	_, err := rc.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, values)
+		return nil
+	})
+	return total, err
+}
+
+// GetUnread 返回用户各会话的未读数和总未读数，计数尚未重建时 ok 为 false
+func (rc *RedisClient) GetUnread(ctx context.Context, username string) (counts map[string]int64, total int64, ok bool, err error) {
+	values, err := rc.Client.HGetAll(ctx, unreadKeyPrefix+username).Result()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if _, ok := values[unreadTotalField]; !ok {
+		return nil, 0, false, nil
+	}
+	counts = make(map[string]int64, len(values)-1)
+	for field, value := range values {
+		count, _ := strconv.ParseInt(value, 10, 64)
+		if field == unreadTotalField {
+			total = count
+			continue
+		}
+		counts[field] = count
+	}
+	return counts, total, true, nil
+}
+
+// ClaimUnreadCheck 领取用户未读计数的核对，interval 内只有第一次调用返回 true
+func (rc *RedisClient) ClaimUnreadCheck(ctx context.Context, username string, interval time.Duration) (bool, error) {
+	return rc.Client.SetNX(ctx, unreadCheckedKeyPrefix+username, 1, interval).Result()
+}
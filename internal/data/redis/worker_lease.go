@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	// workerKeyPrefix 雪花算法 worker ID 租约，值为持有者标识
+	workerKeyPrefix = "im:snowflake:worker:"
+	// WorkerLeaseTTL worker ID 租约有效期，持有者需要在此时间内续约
+	WorkerLeaseTTL = 30 * time.Second
+)
+
+// ErrNoWorkerID 所有 worker ID 都已被占用
+var ErrNoWorkerID = errors.New("没有可用的 worker ID")
+
+// renewWorkerScript 只有租约仍属于自己时才续约
+const renewWorkerScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`
+
+// AcquireWorkerID 从 start 开始依次尝试租用 [0, max) 范围内的 worker ID
+func (rc *RedisClient) AcquireWorkerID(ctx context.Context, owner string, start, max int64) (int64, error) {
+	for i := int64(0); i < max; i++ {
+		id := (start + i) % max
+		ok, err := rc.Client.SetNX(ctx, workerKeyPrefix+strconv.FormatInt(id, 10), owner, WorkerLeaseTTL).Result()
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return id, nil
+		}
+	}
+	return 0, ErrNoWorkerID
+}
+
+// RenewWorkerID 续约 worker ID，租约已过期或被他人占用时返回 false
+func (rc *RedisClient) RenewWorkerID(ctx context.Context, owner string, id int64) (bool, error) {
+	result, err := rc.Client.Eval(ctx, renewWorkerScript,
+		[]string{workerKeyPrefix + strconv.FormatInt(id, 10)},
+		owner, WorkerLeaseTTL.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return result == 1, nil
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalPathPrefix 本地存储下载地址的路径前缀，由网关的 HTTP 服务处理
+const LocalPathPrefix = "/attachments/"
+
+// LocalStorage 将对象保存在本地文件系统中，下载地址由网关签名后直接提供
+type LocalStorage struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+}
+
+// NewLocalStorage 创建本地存储，root 不存在时自动创建
+func NewLocalStorage(root, publicURL, signingKey string) (*LocalStorage, error) {
+	if root == "" {
+		return nil, errors.New("本地存储目录不能为空")
+	}
+	if signingKey == "" {
+		return nil, errors.New("本地存储签名密钥不能为空")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStorage{
+		root:       root,
+		publicURL:  strings.TrimRight(publicURL, "/"),
+		signingKey: []byte(signingKey),
+	}, nil
+}
+
+// path 返回 key 对应的文件路径
+func (l *LocalStorage) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("非法的对象 key: %s", key)
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// Put 先写入临时文件再重命名，读取方不会看到写了一半的文件
+func (l *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if written != size {
+		return fmt.Errorf("写入长度 %d 与声明的长度 %d 不一致", written, size)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get 打开对象对应的文件
+func (l *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// Exists 判断对象对应的文件是否存在
+func (l *LocalStorage) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Delete 删除对象对应的文件
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL 生成带过期时间和签名的网关下载地址
+func (l *LocalStorage) URL(ctx context.Context, key, fileName string, expires time.Duration) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("非法的对象 key: %s", key)
+	}
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expiresAt)
+	query.Set("name", fileName)
+	query.Set("sig", l.sign(key, expiresAt, fileName))
+	return l.publicURL + LocalPathPrefix + key + "?" + query.Encode(), nil
+}
+
+// sign 对下载地址中的参数签名
+func (l *LocalStorage) sign(key, expiresAt, fileName string) string {
+	mac := hmac.New(sha256.New, l.signingKey)
+	mac.Write([]byte(key + "\n" + expiresAt + "\n" + fileName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP 校验签名和有效期后返回文件内容
+func (l *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, LocalPathPrefix)
+	query := r.URL.Query()
+	expiresAt := query.Get("expires")
+	fileName := query.Get("name")
+
+	expires, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		http.Error(w, "下载地址已过期", http.StatusForbidden)
+		return
+	}
+	if !hmac.Equal([]byte(query.Get("sig")), []byte(l.sign(key, expiresAt, fileName))) {
+		http.Error(w, "下载地址无效", http.StatusForbidden)
+		return
+	}
+
+	path, err := l.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		http.Error(w, "读取文件失败", http.StatusInternalServerError)
+		return
+	}
+	if fileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	http.ServeContent(w, r, fileName, info.ModTime(), file)
+}
@@ -0,0 +1,347 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testAccessKey = "test-access-key"
+	testSecretKey = "test-secret-key"
+	testRegion    = "cn-north-1"
+	testBucket    = "im-attachments"
+)
+
+// fakeS3 内存中的 S3 服务，按 Signature V4 独立校验每个请求的签名
+type fakeS3 struct {
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+	// requests 收到的请求，按 "方法 对象" 记录
+	requests []string
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	f := &fakeS3{objects: map[string][]byte{}, contentTypes: map[string]string{}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return f, server
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := verifySigV4(r); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	prefix := "/" + testBucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+key)
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = data
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		if disposition := r.URL.Query().Get("response-content-disposition"); disposition != "" {
+			w.Header().Set("Content-Disposition", disposition)
+		}
+		w.Header().Set("Content-Type", f.contentTypes[key])
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// verifySigV4 按 AWS 文档的步骤重新计算签名，支持 Authorization 头和预签名地址两种方式
+func verifySigV4(r *http.Request) error {
+	query := r.URL.Query()
+	var credential, signedHeaders, signature, amzDate, payloadHash string
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 ") {
+			return errors.New("签名算法错误")
+		}
+		for _, part := range strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 "), ", ") {
+			name, value, _ := strings.Cut(part, "=")
+			switch name {
+			case "Credential":
+				credential = value
+			case "SignedHeaders":
+				signedHeaders = value
+			case "Signature":
+				signature = value
+			}
+		}
+		amzDate = r.Header.Get("X-Amz-Date")
+		payloadHash = r.Header.Get("X-Amz-Content-Sha256")
+		if payloadHash == "" {
+			return errors.New("缺少 X-Amz-Content-Sha256")
+		}
+	} else {
+		if query.Get("X-Amz-Algorithm") != "AWS4-HMAC-SHA256" {
+			return errors.New("缺少签名")
+		}
+		credential = query.Get("X-Amz-Credential")
+		signedHeaders = query.Get("X-Amz-SignedHeaders")
+		signature = query.Get("X-Amz-Signature")
+		amzDate = query.Get("X-Amz-Date")
+		payloadHash = "UNSIGNED-PAYLOAD"
+		query.Del("X-Amz-Signature")
+
+		signedAt, err := time.Parse("20060102T150405Z", amzDate)
+		if err != nil {
+			return err
+		}
+		expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+		if err != nil {
+			return err
+		}
+		if time.Now().After(signedAt.Add(time.Duration(expires) * time.Second)) {
+			return errors.New("预签名地址已过期")
+		}
+	}
+
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[0] != testAccessKey || parts[2] != testRegion || parts[3] != "s3" || parts[4] != "aws4_request" {
+		return fmt.Errorf("凭证范围错误: %s", credential)
+	}
+	if !strings.HasPrefix(amzDate, parts[1]) {
+		return errors.New("签名日期与凭证范围不一致")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var pairs []string
+	for _, name := range names {
+		for _, value := range query[name] {
+			pairs = append(pairs, awsEscape(name)+"="+awsEscape(value))
+		}
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		strings.Join(pairs, "&"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + strings.Join(parts[1:], "/") + "\n" + hex.EncodeToString(hash[:])
+
+	key := []byte("AWS4" + testSecretKey)
+	for _, data := range []string{parts[1], testRegion, "s3", "aws4_request", stringToSign} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(data))
+		key = mac.Sum(nil)
+	}
+	if !hmac.Equal([]byte(hex.EncodeToString(key)), []byte(signature)) {
+		return errors.New("SignatureDoesNotMatch")
+	}
+	return nil
+}
+
+// awsEscape 查询参数按 RFC 3986 编码，空格编码为 %20
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func newTestS3Storage(t *testing.T, endpoint, secretKey string) *S3Storage {
+	s, err := NewS3Storage(S3Config{
+		Endpoint:  endpoint,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: secretKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestS3Storage(t *testing.T) {
+	fake, server := newFakeS3(t)
+	s := newTestS3Storage(t, server.URL, testSecretKey)
+	ctx := context.Background()
+	// key 中的空格和中文需要按 S3 的规则编码后参与签名
+	key := "attachments/2024/报告 v1.pdf"
+	content := "hello s3"
+
+	if err := s.Put(ctx, key, strings.NewReader(content), int64(len(content)), "application/pdf"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if got := fake.contentTypes[key]; got != "application/pdf" {
+		t.Fatalf("Content-Type = %q, want application/pdf", got)
+	}
+
+	exists, err := s.Exists(ctx, key)
+	if err != nil || !exists {
+		t.Fatalf("Exists() = %v, %v, want true", exists, err)
+	}
+
+	body, err := s.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil || string(data) != content {
+		t.Fatalf("Get() = %q, %v, want %q", data, err, content)
+	}
+
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("删除后 Get() error = %v, want %v", err, ErrNotFound)
+	}
+	exists, err = s.Exists(ctx, key)
+	if err != nil || exists {
+		t.Fatalf("删除后 Exists() = %v, %v, want false", exists, err)
+	}
+	// 删除不存在的对象不返回错误
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("重复 Delete() error = %v", err)
+	}
+
+	want := []string{"PUT " + key, "HEAD " + key, "GET " + key, "DELETE " + key, "GET " + key, "HEAD " + key, "DELETE " + key}
+	if strings.Join(fake.requests, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("requests = %q, want %q", fake.requests, want)
+	}
+}
+
+func TestS3StorageURL(t *testing.T) {
+	fake, server := newFakeS3(t)
+	s := newTestS3Storage(t, server.URL, testSecretKey)
+	ctx := context.Background()
+	key := "attachments/photo 1.jpg"
+	fake.objects[key] = []byte("jpeg")
+
+	tests := []struct {
+		name       string
+		fileName   string
+		expires    time.Duration
+		tamper     func(u *url.URL)
+		wantStatus int
+	}{
+		{name: "预签名地址可以直接下载", fileName: "照片.jpg", expires: time.Minute, wantStatus: http.StatusOK},
+		{name: "不带文件名", expires: time.Minute, wantStatus: http.StatusOK},
+		{
+			name:    "篡改对象后签名失效",
+			expires: time.Minute,
+			tamper: func(u *url.URL) {
+				u.Path = "/" + testBucket + "/attachments/other.jpg"
+				u.RawPath = ""
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:    "篡改有效期后签名失效",
+			expires: time.Minute,
+			tamper: func(u *url.URL) {
+				query := u.Query()
+				query.Set("X-Amz-Expires", "604800")
+				u.RawQuery = query.Encode()
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := s.URL(ctx, key, tt.fileName, tt.expires)
+			if err != nil {
+				t.Fatalf("URL() error = %v", err)
+			}
+			u, err := url.Parse(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Query().Get("X-Amz-Expires"); got != strconv.Itoa(int(tt.expires/time.Second)) {
+				t.Fatalf("X-Amz-Expires = %s", got)
+			}
+			if tt.tamper != nil {
+				tt.tamper(u)
+			}
+			resp, err := http.Get(u.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				body, _ := io.ReadAll(resp.Body)
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus == http.StatusOK && tt.fileName != "" &&
+				!strings.Contains(resp.Header.Get("Content-Disposition"), "attachment") {
+				t.Fatalf("Content-Disposition = %q", resp.Header.Get("Content-Disposition"))
+			}
+		})
+	}
+}
+
+func TestS3StorageURLMaxExpiry(t *testing.T) {
+	s := newTestS3Storage(t, "http://127.0.0.1:9100", testSecretKey)
+	raw, err := s.URL(context.Background(), "a.bin", "", 30*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(raw)
+	if got := u.Query().Get("X-Amz-Expires"); got != strconv.Itoa(int(s3MaxPresignExpiry/time.Second)) {
+		t.Fatalf("X-Amz-Expires = %s, want %d", got, int(s3MaxPresignExpiry/time.Second))
+	}
+}
+
+func TestS3StorageWrongSecret(t *testing.T) {
+	_, server := newFakeS3(t)
+	s := newTestS3Storage(t, server.URL, "wrong-secret")
+	err := s.Put(context.Background(), "a.bin", strings.NewReader("x"), 1, "")
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Put() error = %v, want 403", err)
+	}
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// 存储后端类型
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// ErrNotFound 对象不存在
+var ErrNotFound = errors.New("对象不存在")
+
+// Storage 附件内容的存储后端，key 为以 / 分隔的相对路径
+type Storage interface {
+	// Put 写入对象，size 为内容长度
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get 读取对象，调用方负责关闭
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists 判断对象是否存在
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete 删除对象，对象不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 生成有效期为 expires 的下载地址，fileName 为下载时的文件名
+	URL(ctx context.Context, key, fileName string, expires time.Duration) (string, error)
+}
+
+// Config 存储后端配置
+type Config struct {
+	Backend    string   `yaml:"Backend"`    // local 或 s3，为空时使用 local
+	LocalDir   string   `yaml:"LocalDir"`   // 本地存储的根目录
+	PublicURL  string   `yaml:"PublicURL"`  // 本地存储下载地址的前缀，即网关的 HTTP 地址
+	SigningKey string   `yaml:"SigningKey"` // 本地存储下载地址的签名密钥
+	S3         S3Config `yaml:"S3"`
+}
+
+// S3Config S3 兼容存储的配置，MinIO 等服务使用路径风格的地址
+type S3Config struct {
+	Endpoint  string `yaml:"Endpoint"` // 如 http://127.0.0.1:9100
+	Region    string `yaml:"Region"`
+	Bucket    string `yaml:"Bucket"`
+	AccessKey string `yaml:"AccessKey"`
+	SecretKey string `yaml:"SecretKey"`
+}
+
+// NewStorage 根据配置创建存储后端
+func NewStorage(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case "", BackendLocal:
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicURL, cfg.SigningKey)
+	case BackendS3:
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("不支持的存储后端: %s", cfg.Backend)
+	}
+}
+
+// validKey 判断 key 是否为合法的相对路径，拒绝空段和 . / .. 以免越出存储根目录
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
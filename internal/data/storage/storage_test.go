@@ -0,0 +1,28 @@
+package storage
+
+import "testing"
+
+func TestValidKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "avatar.png", want: true},
+		{key: "attachments/2024/01/file.bin", want: true},
+		{key: "a/.hidden", want: true},
+		{key: "a/..b", want: true},
+		{key: "", want: false},
+		{key: "/abs/path", want: false},
+		{key: "dir/", want: false},
+		{key: "a//b", want: false},
+		{key: ".", want: false},
+		{key: "..", want: false},
+		{key: "a/./b", want: false},
+		{key: "a/../../etc/passwd", want: false},
+	}
+	for _, tt := range tests {
+		if got := validKey(tt.key); got != tt.want {
+			t.Errorf("validKey(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
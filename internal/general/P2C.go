@@ -5,11 +5,15 @@ import (
 	"im-service/internal/loadmonitor"
 	"log"
 	"math/rand"
+	"sync"
 	"time"
 )
 
-// 全局变量，记录上一次强制选择的时间
-var lastForcedSelectionTime time.Time
+// 全局变量，记录上一次强制选择的时间，每次 RPC 调用都会并发访问
+var (
+	lastForcedSelectionTime   time.Time
+	lastForcedSelectionTimeMu sync.Mutex
+)
 
 // 强制选择的时间间隔，例如 10 分钟
 const forcedSelectionInterval = 10 * time.Minute
@@ -25,7 +29,14 @@ func PickServerWithP2C(endpoints []string, lm *loadmonitor.LoadMonitor) (string,
 	}
 
 	// 检查是否需要强制选择
-	if time.Since(lastForcedSelectionTime) >= forcedSelectionInterval {
+	lastForcedSelectionTimeMu.Lock()
+	forced := time.Since(lastForcedSelectionTime) >= forcedSelectionInterval
+	if forced {
+		// 更新最后一次强制选择的时间
+		lastForcedSelectionTime = time.Now()
+	}
+	lastForcedSelectionTimeMu.Unlock()
+	if forced {
 		log.Printf("超过一定时间，进行强制选择")
 		endpoint := pickLowestLoadServer(endpoints, lm)
 		log.Printf("使用的Endpoint:%s", endpoint)
@@ -33,7 +44,7 @@ func PickServerWithP2C(endpoints []string, lm *loadmonitor.LoadMonitor) (string,
 	}
 
 	// 随机选择两个不同的服务实例
-	log.Printf("随机选择两个不同的服务实例")
+	//log.Printf("随机选择两个不同的服务实例")
 	index1, index2 := rand.Intn(len(endpoints)), rand.Intn(len(endpoints))
 	for index2 == index1 {
 		index2 = rand.Intn(len(endpoints))
@@ -48,10 +59,10 @@ func PickServerWithP2C(endpoints []string, lm *loadmonitor.LoadMonitor) (string,
 
 	// 选择负载较低的服务实例
 	if load1 <= load2 {
-		log.Printf("使用的Endpoint:%s", endpoint1)
+		//log.Printf("使用的Endpoint:%s", endpoint1)
 		return endpoint1, nil
 	}
-	log.Printf("使用的Endpoint:%s", endpoint2)
+	//log.Printf("使用的Endpoint:%s", endpoint2)
 	return endpoint2, nil
 }
 
@@ -68,8 +79,5 @@ func pickLowestLoadServer(endpoints []string, lm *loadmonitor.LoadMonitor) strin
 		}
 	}
 
-	// 更新最后一次强制选择的时间
-	lastForcedSelectionTime = time.Now()
-
 	return lowestLoadEndpoint
 }
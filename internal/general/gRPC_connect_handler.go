@@ -2,19 +2,24 @@ package general
 
 import (
 	"context"
+	"fmt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"im-service/internal/loadmonitor"
 	"net"
+	"time"
 )
 
 // CreateGRPCConnection  创建 gRPC 连接
+// 返回的连接应在进程内共享，端点选择由 P2C 负载均衡器在每次 RPC 调用时完成
 func CreateGRPCConnection(endpoints []string, lm *loadmonitor.LoadMonitor) (*grpc.ClientConn, error) {
-	// 使用P2C算法选择服务实例
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("没有可用的端点")
+	}
 
-	target, err := PickServerWithP2C(endpoints, lm)
-	if err != nil {
-		return nil, err
+	state := &p2cState{
+		lm:      lm,
+		ejected: make(map[string]time.Time),
 	}
 
 	// 创建拨号选项
@@ -24,10 +29,12 @@ func CreateGRPCConnection(endpoints []string, lm *loadmonitor.LoadMonitor) (*grp
 			d := net.Dialer{}
 			return d.DialContext(ctx, "tcp", addr)
 		}),
+		grpc.WithResolvers(&staticResolverBuilder{endpoints: endpoints, state: state}),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig":[{"%s":{}}]}`, p2cBalancerName)),
 	}
 
-	// 创建连接
-	conn, err := grpc.NewClient(target, opts...)
+	// 创建连接，实际拨号在首次调用时于后台进行，单个端点不可用不会导致失败
+	conn, err := grpc.NewClient(p2cScheme+":///im-service", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,55 +1,36 @@
 package general
 
 import (
-	"fmt"
-	"github.com/gorilla/websocket"
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
 	"log"
 	"time"
 )
 
+// HeartbeatInterval 服务端发送心跳的间隔
+const HeartbeatInterval = 30 * time.Second
+
+// HeartbeatTimeout 超过该时间未收到客户端任何帧则断开连接
+const HeartbeatTimeout = 60 * time.Second
+
 // SendHeartBeat 发送心跳包
-func SendHeartBeat(conn *websocket.Conn) {
-	ticker := time.NewTicker(30 * time.Second)
+func SendHeartBeat(conn *websocket2.WebSocketConnection) {
+	ticker := time.NewTicker(HeartbeatInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
-			// 发送心跳消息
-			if err := conn.WriteMessage(websocket.TextMessage, []byte("heartbeat")); err != nil {
+			// 发送心跳帧
+			frame := protocol.NewPush()
+			frame.Body = &protocol.Frame_Heartbeat{Heartbeat: &protocol.Heartbeat{}}
+			if err := conn.WriteFrame(frame); err != nil {
 				log.Printf("发送心跳包失败: %v", err)
 				return
 			}
+		case <-conn.Done():
+			return
 		}
 	}
 
 }
-
-// ListenForMessages 监听心跳包
-func ListenForMessages(conn *websocket.Conn) {
-	// 心跳超时时间，例如 60 秒
-	heartbeatTimeout := 60 * time.Second
-	lastHeartbeatTime := time.Now()
-
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			log.Println("读取消息错误:", err)
-			break
-		}
-
-		if string(message) == "heartbeat" {
-			fmt.Println("收到心跳消息")
-			lastHeartbeatTime = time.Now() // 更新最后一次收到心跳的时间
-		} else {
-			fmt.Println("收到消息:", string(message))
-		}
-
-		// 检查是否超时
-		if time.Since(lastHeartbeatTime) > heartbeatTimeout {
-			log.Println("连接超时，尝试重新连接")
-			// 重新建立连接
-			Reconnect(conn)
-		}
-	}
-}
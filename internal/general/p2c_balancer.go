@@ -0,0 +1,142 @@
+package general
+
+import (
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+	"im-service/internal/loadmonitor"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// p2cScheme 静态端点解析器的 scheme
+	p2cScheme = "p2c"
+	// p2cBalancerName P2C 负载均衡器名称
+	p2cBalancerName = "p2c"
+	// ejectionDuration 调用返回 Unavailable 的端点被剔除的时长，到期后重新参与选择
+	ejectionDuration = 30 * time.Second
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(p2cBalancerName, &p2cPickerBuilder{}, base.Config{}))
+}
+
+// p2cStateKey 地址属性中存放 p2cState 的键
+type p2cStateKey struct{}
+
+// p2cState 一个客户端连接共享的负载信息和端点剔除状态，随地址属性传给 picker
+type p2cState struct {
+	lm      *loadmonitor.LoadMonitor
+	mu      sync.Mutex
+	ejected map[string]time.Time // 端点 -> 剔除截止时间
+}
+
+// eject 剔除端点一段时间
+func (s *p2cState) eject(endpoint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ejected[endpoint] = time.Now().Add(ejectionDuration)
+}
+
+// available 过滤掉仍在剔除期内的端点，全部被剔除时退化为使用所有端点
+func (s *p2cState) available(endpoints []string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	candidates := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if until, ok := s.ejected[endpoint]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(s.ejected, endpoint)
+		}
+		candidates = append(candidates, endpoint)
+	}
+	if len(candidates) == 0 {
+		return endpoints
+	}
+	return candidates
+}
+
+// staticResolverBuilder 把配置中的端点列表解析为地址，并附带 p2cState
+type staticResolverBuilder struct {
+	endpoints []string
+	state     *p2cState
+}
+
+func (b *staticResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	addrs := make([]resolver.Address, 0, len(b.endpoints))
+	for _, endpoint := range b.endpoints {
+		addrs = append(addrs, resolver.Address{
+			Addr:               endpoint,
+			BalancerAttributes: attributes.New(p2cStateKey{}, b.state),
+		})
+	}
+	if err := cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+		return nil, err
+	}
+	return staticResolver{}, nil
+}
+
+func (b *staticResolverBuilder) Scheme() string {
+	return p2cScheme
+}
+
+// staticResolver 端点固定，无需重新解析
+type staticResolver struct{}
+
+func (staticResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (staticResolver) Close() {}
+
+// p2cPickerBuilder 每当就绪的子连接发生变化时重建 picker
+// 连接断开的端点不在就绪列表中，由 gRPC 在后台按退避策略重连，恢复后自动重新加入
+type p2cPickerBuilder struct{}
+
+func (*p2cPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &p2cPicker{subConns: make(map[string]balancer.SubConn, len(info.ReadySCs))}
+	for sc, sci := range info.ReadySCs {
+		p.subConns[sci.Address.Addr] = sc
+		p.endpoints = append(p.endpoints, sci.Address.Addr)
+		if state, ok := sci.Address.BalancerAttributes.Value(p2cStateKey{}).(*p2cState); ok {
+			p.state = state
+		}
+	}
+	sort.Strings(p.endpoints)
+	return p
+}
+
+// p2cPicker 每次 RPC 调用都使用 P2C 算法选择端点
+type p2cPicker struct {
+	endpoints []string
+	subConns  map[string]balancer.SubConn
+	state     *p2cState
+}
+
+func (p *p2cPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	if p.state == nil {
+		return balancer.PickResult{}, status.Error(codes.Internal, "缺少 P2C 负载均衡状态")
+	}
+	endpoint, err := PickServerWithP2C(p.state.available(p.endpoints), p.state.lm)
+	if err != nil {
+		return balancer.PickResult{}, balancer.ErrNoSubConnAvailable
+	}
+	return balancer.PickResult{
+		SubConn: p.subConns[endpoint],
+		Done: func(info balancer.DoneInfo) {
+			// 端点不可用时剔除一段时间，期间的调用落到其他端点
+			if info.Err != nil && status.Code(info.Err) == codes.Unavailable {
+				p.state.eject(endpoint)
+			}
+		},
+	}, nil
+}
@@ -0,0 +1,103 @@
+package general
+
+import (
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"im-service/internal/loadmonitor"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeSubConn 只用于区分 picker 选中的端点
+type fakeSubConn struct {
+	balancer.SubConn
+	endpoint string
+}
+
+func TestP2CStateAvailable(t *testing.T) {
+	endpoints := []string{"a:1", "b:1", "c:1"}
+	tests := []struct {
+		name    string
+		ejected map[string]time.Duration // 端点 -> 剔除截止时间相对当前的偏移
+		want    []string
+	}{
+		{name: "没有剔除", want: endpoints},
+		{name: "剔除一个", ejected: map[string]time.Duration{"b:1": time.Minute}, want: []string{"a:1", "c:1"}},
+		{name: "剔除已到期", ejected: map[string]time.Duration{"b:1": -time.Second}, want: endpoints},
+		{name: "全部剔除时使用所有端点", ejected: map[string]time.Duration{"a:1": time.Minute, "b:1": time.Minute, "c:1": time.Minute}, want: endpoints},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &p2cState{ejected: map[string]time.Time{}}
+			for endpoint, offset := range tt.ejected {
+				state.ejected[endpoint] = time.Now().Add(offset)
+			}
+			if got := state.available(endpoints); !slices.Equal(got, tt.want) {
+				t.Errorf("available() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestP2CPickerPick(t *testing.T) {
+	// 避免强制选择分支输出日志并总是选择第一个端点
+	lastForcedSelectionTimeMu.Lock()
+	lastForcedSelectionTime = time.Now()
+	lastForcedSelectionTimeMu.Unlock()
+
+	subConns := map[string]balancer.SubConn{
+		"a:1": &fakeSubConn{endpoint: "a:1"},
+		"b:1": &fakeSubConn{endpoint: "b:1"},
+	}
+	tests := []struct {
+		name      string
+		endpoints []string
+		ejected   []string
+		done      error // 调用结束时返回的错误
+		want      []string
+		ejects    bool
+	}{
+		{name: "单个端点", endpoints: []string{"a:1"}, want: []string{"a:1"}},
+		{name: "两个端点负载相同", endpoints: []string{"a:1", "b:1"}, want: []string{"a:1", "b:1"}},
+		{name: "避开被剔除的端点", endpoints: []string{"a:1", "b:1"}, ejected: []string{"a:1"}, want: []string{"b:1"}},
+		{name: "Unavailable 时剔除端点", endpoints: []string{"a:1"}, done: status.Error(codes.Unavailable, "down"), want: []string{"a:1"}, ejects: true},
+		{name: "其他错误不剔除", endpoints: []string{"a:1"}, done: status.Error(codes.NotFound, "missing"), want: []string{"a:1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &p2cState{lm: loadmonitor.NewLoadMonitor(""), ejected: map[string]time.Time{}}
+			for _, endpoint := range tt.ejected {
+				state.eject(endpoint)
+			}
+			p := &p2cPicker{endpoints: tt.endpoints, subConns: subConns, state: state}
+			for i := 0; i < 20; i++ {
+				result, err := p.Pick(balancer.PickInfo{})
+				if err != nil {
+					t.Fatalf("Pick() error = %v", err)
+				}
+				if picked := result.SubConn.(*fakeSubConn).endpoint; !slices.Contains(tt.want, picked) {
+					t.Fatalf("Pick() = %s, want one of %q", picked, tt.want)
+				}
+				result.Done(balancer.DoneInfo{Err: tt.done})
+			}
+			for _, endpoint := range tt.endpoints {
+				_, ejected := state.ejected[endpoint]
+				if ejected && !slices.Contains(tt.ejected, endpoint) && !tt.ejects {
+					t.Errorf("endpoint %s ejected unexpectedly", endpoint)
+				}
+			}
+			if tt.ejects && len(state.ejected) == 0 {
+				t.Errorf("Unavailable did not eject the endpoint")
+			}
+		})
+	}
+}
+
+func TestP2CPickerWithoutState(t *testing.T) {
+	p := &p2cPicker{endpoints: []string{"a:1"}}
+	if _, err := p.Pick(balancer.PickInfo{}); status.Code(err) != codes.Internal {
+		t.Errorf("Pick() error = %v, want Internal", err)
+	}
+}
@@ -0,0 +1,159 @@
+package general
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"im-service/internal/data/redis"
+	"log"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// snowflakeEpoch 自定义纪元，2024-01-01 00:00:00 UTC，毫秒
+	snowflakeEpoch = int64(1704067200000)
+	workerBits     = 10
+	sequenceBits   = 12
+	maxWorkerID    = int64(1)<<workerBits - 1
+	maxSequence    = int64(1)<<sequenceBits - 1
+	// maxClockBackward 允许等待的最大时钟回拨，超过时直接报错
+	maxClockBackward = 10 * time.Millisecond
+)
+
+// ErrWorkerLeaseLost worker ID 租约已丢失，暂时无法生成 ID
+var ErrWorkerLeaseLost = errors.New("worker ID 租约已丢失")
+
+// Snowflake 雪花算法 ID 生成器：41 位毫秒时间戳 + 10 位 worker ID + 12 位序列号
+// 生成的 ID 全局唯一且按时间递增，worker ID 通过 Redis 租约分配，进程间不会冲突
+type Snowflake struct {
+	redisClient *redis.RedisClient
+	owner       string
+
+	mu       sync.Mutex
+	workerID int64
+	leased   bool
+	// leasedAt 最近一次成功租用或续约的时间，超过 WorkerLeaseTTL 未续约时租约可能已被他人占用
+	leasedAt time.Time
+	lastTime int64
+	sequence int64
+}
+
+// NewSnowflake 创建 ID 生成器并租用一个 worker ID
+func NewSnowflake(ctx context.Context, redisClient *redis.RedisClient) (*Snowflake, error) {
+	hostname, _ := os.Hostname()
+	s := &Snowflake{
+		redisClient: redisClient,
+		owner:       hostname + ":" + strconv.Itoa(os.Getpid()) + ":" + strconv.FormatInt(time.Now().UnixNano(), 36),
+	}
+	if err := s.acquire(ctx); err != nil {
+		return nil, fmt.Errorf("租用 worker ID 失败: %w", err)
+	}
+	log.Printf("雪花算法 worker ID: %d", s.workerID)
+	return s, nil
+}
+
+// KeepAlive 定期续约 worker ID，租约丢失或超过有效期仍未续约成功时重新租用，期间生成 ID 会返回错误
+func (s *Snowflake) KeepAlive(ctx context.Context) {
+	ticker := time.NewTicker(redis.WorkerLeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		s.renew(ctx)
+	}
+}
+
+// renew 续约一次 worker ID，租约已不可用时重新租用
+func (s *Snowflake) renew(ctx context.Context) {
+	s.mu.Lock()
+	workerID, leased := s.workerID, s.leased
+	s.mu.Unlock()
+
+	if leased {
+		// 以发出请求的时间作为续约时间，Redis 中的有效期不会早于它结束
+		start := time.Now()
+		ok, err := s.redisClient.RenewWorkerID(ctx, s.owner, workerID)
+		if err != nil {
+			log.Printf("续约 worker ID %d 失败: %v", workerID, err)
+			// Redis 暂时不可用时，租约有效期内继续使用当前 worker ID；超过有效期后可能已被他人租用
+			s.mu.Lock()
+			if s.leased && time.Since(s.leasedAt) >= redis.WorkerLeaseTTL {
+				log.Printf("worker ID %d 超过有效期未续约，停止生成 ID", workerID)
+				s.leased = false
+			}
+			s.mu.Unlock()
+			return
+		}
+		if ok {
+			s.mu.Lock()
+			s.leasedAt = start
+			s.mu.Unlock()
+			return
+		}
+		log.Printf("worker ID %d 的租约已丢失，重新租用", workerID)
+		s.mu.Lock()
+		s.leased = false
+		s.mu.Unlock()
+	}
+
+	if err := s.acquire(ctx); err != nil {
+		log.Printf("重新租用 worker ID 失败: %v", err)
+	}
+}
+
+// acquire 租用一个新的 worker ID
+func (s *Snowflake) acquire(ctx context.Context) error {
+	start := time.Now()
+	workerID, err := s.redisClient.AcquireWorkerID(ctx, s.owner, rand.Int63n(maxWorkerID+1), maxWorkerID+1)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.workerID = workerID
+	s.leased = true
+	s.leasedAt = start
+	s.mu.Unlock()
+	return nil
+}
+
+// NextID 生成下一个 ID
+func (s *Snowflake) NextID() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// 续约协程未能及时续约时同样拒绝生成，避免与新的持有者产生重复 ID
+	if !s.leased || time.Since(s.leasedAt) >= redis.WorkerLeaseTTL {
+		return 0, ErrWorkerLeaseLost
+	}
+
+	now := time.Now().UnixMilli()
+	if now < s.lastTime {
+		// 时钟回拨，短时间内等待追上，否则拒绝生成以免产生重复 ID
+		backward := time.Duration(s.lastTime-now) * time.Millisecond
+		if backward > maxClockBackward {
+			return 0, fmt.Errorf("时钟回拨 %v，拒绝生成 ID", backward)
+		}
+		time.Sleep(backward)
+		now = time.Now().UnixMilli()
+	}
+	if now == s.lastTime {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			// 当前毫秒的序列号已用完，等待下一毫秒
+			for now <= s.lastTime {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Now().UnixMilli()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastTime = now
+	return (now-snowflakeEpoch)<<(workerBits+sequenceBits) | s.workerID<<sequenceBits | s.sequence, nil
+}
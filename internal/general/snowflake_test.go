@@ -0,0 +1,89 @@
+package general
+
+import (
+	"context"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"im-service/internal/data/redis"
+	"testing"
+	"time"
+)
+
+func TestSnowflakeNextID(t *testing.T) {
+	tests := []struct {
+		name     string
+		sf       *Snowflake
+		count    int
+		wantErr  error
+		anyError bool
+	}{
+		{name: "连续生成递增且唯一", sf: &Snowflake{workerID: 5, leased: true, leasedAt: time.Now()}, count: 10000},
+		{name: "最大 worker ID", sf: &Snowflake{workerID: maxWorkerID, leased: true, leasedAt: time.Now()}, count: 100},
+		{name: "租约丢失", sf: &Snowflake{workerID: 5}, count: 1, wantErr: ErrWorkerLeaseLost},
+		{name: "超过有效期未续约", sf: &Snowflake{workerID: 5, leased: true, leasedAt: time.Now().Add(-redis.WorkerLeaseTTL)}, count: 1, wantErr: ErrWorkerLeaseLost},
+		{name: "时钟回拨超过上限", sf: &Snowflake{workerID: 5, leased: true, leasedAt: time.Now(), lastTime: time.Now().Add(time.Second).UnixMilli()}, count: 1, anyError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[int64]bool, tt.count)
+			var last int64
+			for i := 0; i < tt.count; i++ {
+				id, err := tt.sf.NextID()
+				if tt.wantErr != nil || tt.anyError {
+					if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+						t.Fatalf("NextID() error = %v, want %v", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("NextID() error = %v", err)
+				}
+				if id <= last {
+					t.Fatalf("NextID() = %d, not greater than previous %d", id, last)
+				}
+				if seen[id] {
+					t.Fatalf("NextID() returned duplicate %d", id)
+				}
+				if worker := id >> sequenceBits & maxWorkerID; worker != tt.sf.workerID {
+					t.Fatalf("worker ID in %d = %d, want %d", id, worker, tt.sf.workerID)
+				}
+				seen[id] = true
+				last = id
+			}
+		})
+	}
+}
+
+func TestSnowflakeRenew(t *testing.T) {
+	mr := miniredis.RunT(t)
+	ctx := context.Background()
+	sf, err := NewSnowflake(ctx, redis.NewRedisClient(mr.Addr(), ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Redis 不可用但仍在有效期内，继续生成 ID
+	mr.Close()
+	sf.renew(ctx)
+	if _, err := sf.NextID(); err != nil {
+		t.Fatalf("有效期内续约失败后 NextID() error = %v", err)
+	}
+
+	// 超过有效期仍未续约成功，停止生成 ID
+	sf.mu.Lock()
+	sf.leasedAt = time.Now().Add(-redis.WorkerLeaseTTL)
+	sf.mu.Unlock()
+	sf.renew(ctx)
+	if _, err := sf.NextID(); !errors.Is(err, ErrWorkerLeaseLost) {
+		t.Fatalf("超过有效期后 NextID() error = %v, want %v", err, ErrWorkerLeaseLost)
+	}
+
+	// Redis 恢复后重新租用
+	if err := mr.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	sf.renew(ctx)
+	if _, err := sf.NextID(); err != nil {
+		t.Fatalf("重新租用后 NextID() error = %v", err)
+	}
+}
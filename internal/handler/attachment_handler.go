@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/attachment"
+	"im-service/internal/svc"
+	"log"
+)
+
+// dispatchAttachmentFrame 处理附件上传和下载相关的命令，userName 为连接绑定的用户
+func dispatchAttachmentFrame(ctx context.Context, sc *svc.ServiceContext, userName string, frame *protocol.Frame) *protocol.Frame {
+	switch body := frame.Body.(type) {
+	case *protocol.Frame_InitUpload:
+		if body.InitUpload.FileName == "" || body.InitUpload.Size <= 0 || body.InitUpload.Sha256 == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "文件名、大小和哈希不能为空")
+		}
+		result, err := sc.AttachmentClient.InitUpload(ctx, &attachment.InitUploadRequest{
+			Username:   userName,
+			FileName:   body.InitUpload.FileName,
+			MimeType:   body.InitUpload.MimeType,
+			Size:       body.InitUpload.Size,
+			Sha256:     body.InitUpload.Sha256,
+			Width:      body.InitUpload.Width,
+			Height:     body.InitUpload.Height,
+			DurationMs: body.InitUpload.DurationMs,
+		})
+		if err != nil {
+			log.Printf("开始上传附件失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_InitUploadResponse{InitUploadResponse: &protocol.InitUploadResponse{
+			AttachmentId:   result.AttachmentId,
+			Completed:      result.Completed,
+			ChunkSize:      result.ChunkSize,
+			ChunkCount:     result.ChunkCount,
+			UploadedChunks: result.UploadedChunks,
+		}}
+		return resp
+
+	case *protocol.Frame_UploadChunk:
+		if body.UploadChunk.AttachmentId == "" || len(body.UploadChunk.Data) == 0 {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "附件 ID 和分片内容不能为空")
+		}
+		result, err := sc.AttachmentClient.UploadChunk(ctx, &attachment.UploadChunkRequest{
+			Username:     userName,
+			AttachmentId: body.UploadChunk.AttachmentId,
+			Index:        body.UploadChunk.Index,
+			Data:         body.UploadChunk.Data,
+		})
+		if err != nil {
+			log.Printf("上传附件分片失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_UploadChunkResponse{UploadChunkResponse: &protocol.UploadChunkResponse{}}
+		return resp
+
+	case *protocol.Frame_CompleteUpload:
+		if body.CompleteUpload.AttachmentId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "附件 ID 不能为空")
+		}
+		result, err := sc.AttachmentClient.CompleteUpload(ctx, &attachment.CompleteUploadRequest{
+			Username:     userName,
+			AttachmentId: body.CompleteUpload.AttachmentId,
+		})
+		if err != nil {
+			log.Printf("完成附件上传失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_CompleteUploadResponse{CompleteUploadResponse: &protocol.CompleteUploadResponse{
+			Attachment: toProtocolAttachment(result.Attachment),
+		}}
+		return resp
+
+	case *protocol.Frame_GetAttachment:
+		if body.GetAttachment.AttachmentId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "附件 ID 不能为空")
+		}
+		result, err := sc.AttachmentClient.GetAttachment(ctx, &attachment.GetAttachmentRequest{
+			Username:     userName,
+			AttachmentId: body.GetAttachment.AttachmentId,
+		})
+		if err != nil {
+			log.Printf("获取附件失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetAttachmentResponse{GetAttachmentResponse: &protocol.GetAttachmentResponse{
+			Attachment:   toProtocolAttachment(result.Attachment),
+			Url:          result.Url,
+			UrlExpiresAt: result.UrlExpiresAt,
+		}}
+		return resp
+
+	default:
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+}
+
+// isAttachmentCommand 判断命令是否为附件相关的命令
+func isAttachmentCommand(frame *protocol.Frame) bool {
+	switch frame.Body.(type) {
+	case *protocol.Frame_InitUpload, *protocol.Frame_UploadChunk, *protocol.Frame_CompleteUpload, *protocol.Frame_GetAttachment:
+		return true
+	}
+	return false
+}
+
+// toProtocolAttachment 将附件服务返回的附件信息转换为协议中的附件
+func toProtocolAttachment(info *attachment.AttachmentInfo) *protocol.MessageAttachment {
+	if info == nil {
+		return nil
+	}
+	return &protocol.MessageAttachment{
+		AttachmentId: info.AttachmentId,
+		FileName:     info.FileName,
+		MimeType:     info.MimeType,
+		Size:         info.Size,
+		Sha256:       info.Sha256,
+		Width:        info.Width,
+		Height:       info.Height,
+		DurationMs:   info.DurationMs,
+	}
+}
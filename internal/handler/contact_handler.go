@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/friend"
+	"im-service/internal/svc"
+	"log"
+	"time"
+)
+
+// dispatchContactFrame 处理删除好友、黑名单、好友备注、标签和好友推荐相关的命令，userName 为连接绑定的用户
+func dispatchContactFrame(ctx context.Context, sc *svc.ServiceContext, userName string, frame *protocol.Frame) *protocol.Frame {
+	switch body := frame.Body.(type) {
+	case *protocol.Frame_DeleteFriend:
+		if body.DeleteFriend.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "好友不能为空")
+		}
+		result, err := sc.FriendClient.DeleteFriend(ctx, &friend.DeleteFriendRequest{
+			Username: userName,
+			Target:   body.DeleteFriend.Target,
+		})
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_DeleteFriendResponse{DeleteFriendResponse: body}
+		})
+
+	case *protocol.Frame_BlockUser:
+		if body.BlockUser.Target == "" || body.BlockUser.Target == userName {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "无效的用户")
+		}
+		result, err := sc.FriendClient.BlockUser(ctx, &friend.BlockUserRequest{
+			Username: userName,
+			Target:   body.BlockUser.Target,
+		})
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_BlockUserResponse{BlockUserResponse: body}
+		})
+
+	case *protocol.Frame_UnblockUser:
+		if body.UnblockUser.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "用户不能为空")
+		}
+		result, err := sc.FriendClient.UnblockUser(ctx, &friend.BlockUserRequest{
+			Username: userName,
+			Target:   body.UnblockUser.Target,
+		})
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_UnblockUserResponse{UnblockUserResponse: body}
+		})
+
+	case *protocol.Frame_ListBlockedUsers:
+		result, err := sc.FriendClient.ListBlockedUsers(ctx, &friend.ListBlockedUsersRequest{Username: userName})
+		if err != nil {
+			log.Printf("查询黑名单失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		blockedUsers := make([]*protocol.BlockedUser, 0, len(result.BlockedUsers))
+		for _, item := range result.BlockedUsers {
+			blockedUser := &protocol.BlockedUser{Username: item.Username}
+			if t, err := time.Parse(time.RFC3339, item.BlockedAt); err == nil {
+				blockedUser.BlockedAt = t.UnixMilli()
+			}
+			blockedUsers = append(blockedUsers, blockedUser)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_ListBlockedUsersResponse{ListBlockedUsersResponse: &protocol.ListBlockedUsersResponse{
+			BlockedUsers: blockedUsers,
+		}}
+		return resp
+
+	case *protocol.Frame_UpdateFriend:
+		if body.UpdateFriend.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "好友不能为空")
+		}
+		req := &friend.UpdateFriendRequest{
+			Username: userName,
+			Target:   body.UpdateFriend.Target,
+			Remark:   body.UpdateFriend.Remark,
+			Starred:  body.UpdateFriend.Starred,
+		}
+		if body.UpdateFriend.Tags != nil {
+			req.Tags = &friend.FriendTags{Tags: body.UpdateFriend.Tags.Tags}
+		}
+		result, err := sc.FriendClient.UpdateFriend(ctx, req)
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_UpdateFriendResponse{UpdateFriendResponse: body}
+		})
+
+	case *protocol.Frame_GetFriend:
+		if body.GetFriend.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "好友不能为空")
+		}
+		result, err := sc.FriendClient.GetFriend(ctx, &friend.GetFriendRequest{
+			Username: userName,
+			Target:   body.GetFriend.Target,
+		})
+		if err != nil {
+			log.Printf("查询好友失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetFriendResponse{GetFriendResponse: &protocol.GetFriendResponse{
+			Friend: toFriendEntry(result.Friend),
+		}}
+		return resp
+
+	case *protocol.Frame_ListFriendTags:
+		result, err := sc.FriendClient.ListFriendTags(ctx, &friend.ListFriendTagsRequest{Username: userName})
+		if err != nil {
+			log.Printf("查询好友标签失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		tags := make([]*protocol.FriendTag, 0, len(result.Tags))
+		for _, tag := range result.Tags {
+			tags = append(tags, &protocol.FriendTag{Name: tag.Name, Count: tag.Count})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_ListFriendTagsResponse{ListFriendTagsResponse: &protocol.ListFriendTagsResponse{
+			Tags: tags,
+		}}
+		return resp
+
+	case *protocol.Frame_RenameFriendTag:
+		if body.RenameFriendTag.Tag == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "标签不能为空")
+		}
+		result, err := sc.FriendClient.RenameFriendTag(ctx, &friend.RenameFriendTagRequest{
+			Username: userName,
+			Tag:      body.RenameFriendTag.Tag,
+			NewName:  body.RenameFriendTag.NewName,
+		})
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_RenameFriendTagResponse{RenameFriendTagResponse: body}
+		})
+
+	case *protocol.Frame_DeleteFriendTag:
+		if body.DeleteFriendTag.Tag == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "标签不能为空")
+		}
+		result, err := sc.FriendClient.DeleteFriendTag(ctx, &friend.DeleteFriendTagRequest{
+			Username: userName,
+			Tag:      body.DeleteFriendTag.Tag,
+		})
+		return friendOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendOperationResponse) {
+			resp.Body = &protocol.Frame_DeleteFriendTagResponse{DeleteFriendTagResponse: body}
+		})
+
+	case *protocol.Frame_SuggestFriends:
+		result, err := sc.FriendClient.SuggestFriends(ctx, &friend.SuggestFriendsRequest{
+			Username: userName,
+			Limit:    body.SuggestFriends.Limit,
+		})
+		if err != nil {
+			log.Printf("查询好友推荐失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		suggestions := make([]*protocol.FriendSuggestion, 0, len(result.Suggestions))
+		for _, item := range result.Suggestions {
+			suggestions = append(suggestions, &protocol.FriendSuggestion{
+				Username:          item.Username,
+				MutualFriendCount: item.MutualFriendCount,
+				MutualFriends:     item.MutualFriends,
+				SharedGroupCount:  item.SharedGroupCount,
+			})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SuggestFriendsResponse{SuggestFriendsResponse: &protocol.SuggestFriendsResponse{
+			Suggestions: suggestions,
+		}}
+		return resp
+
+	default:
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+}
+
+// isContactCommand 判断命令是否为删除好友、黑名单、好友备注、标签和好友推荐相关的命令
+func isContactCommand(frame *protocol.Frame) bool {
+	switch frame.Body.(type) {
+	case *protocol.Frame_DeleteFriend, *protocol.Frame_BlockUser, *protocol.Frame_UnblockUser, *protocol.Frame_ListBlockedUsers,
+		*protocol.Frame_UpdateFriend, *protocol.Frame_GetFriend, *protocol.Frame_ListFriendTags,
+		*protocol.Frame_RenameFriendTag, *protocol.Frame_DeleteFriendTag, *protocol.Frame_SuggestFriends:
+		return true
+	}
+	return false
+}
+
+// friendOperationResponse 将好友操作的结果转换为响应帧，setBody 负责填入对应的响应字段
+func friendOperationResponse(frame *protocol.Frame, result *friend.FriendOperationResponse, err error, setBody func(*protocol.Frame, *protocol.FriendOperationResponse)) *protocol.Frame {
+	if err != nil {
+		log.Printf("好友操作失败: %v", err)
+		return rpcError(frame, err)
+	}
+	if !result.Success {
+		return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+	}
+	resp := protocol.NewResponse(frame)
+	setBody(resp, &protocol.FriendOperationResponse{})
+	return resp
+}
+
+// toFriendEntry 将好友服务返回的好友条目转换为协议中的格式
+func toFriendEntry(item *friend.FriendEntry) *protocol.FriendEntry {
+	entry := &protocol.FriendEntry{
+		Username: item.Username,
+		Nickname: item.Nickname,
+		Remark:   item.Remark,
+		Tags:     item.Tags,
+		Starred:  item.Starred,
+		Presence: protocol.PresenceStatus(item.Presence),
+		LastSeen: item.LastSeen,
+	}
+	if t, err := time.Parse(time.RFC3339, item.AddedAt); err == nil {
+		entry.AddedAt = t.UnixMilli()
+	}
+	return entry
+}
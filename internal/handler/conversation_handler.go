@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/message"
+	"log"
+	"time"
+)
+
+// HandleGetConversations 处理获取会话列表请求，将会话转换为协议中的格式
+func HandleGetConversations(ctx context.Context, client message.MessageServiceClient, req *message.GetConversationsRequest) (*message.GetConversationsResponse, []*protocol.Conversation, error) {
+	resp, err := client.GetConversations(ctx, req)
+	if err != nil {
+		log.Printf("获取会话列表失败: %v", err)
+		return nil, nil, err
+	}
+
+	conversations := make([]*protocol.Conversation, 0, len(resp.Conversations))
+	for _, item := range resp.Conversations {
+		var lastActivity int64
+		if t, err := time.Parse(time.RFC3339, item.LastActivity); err == nil {
+			lastActivity = t.UnixMilli()
+		}
+		conversation := &protocol.Conversation{
+			ConversationId:      item.ConversationId,
+			Peer:                item.Peer,
+			GroupId:             item.GroupId,
+			UnreadCount:         item.UnreadCount,
+			LastActivity:        lastActivity,
+			Pinned:              item.Pinned,
+			Muted:               item.Muted,
+			Archived:            item.Archived,
+			DisappearingSeconds: item.DisappearingSeconds,
+			Encrypted:           item.Encrypted,
+		}
+		if item.LastMessage != nil {
+			conversation.LastMessage = toNewMessageEvent(item.LastMessage)
+		}
+		conversations = append(conversations, conversation)
+	}
+	return resp, conversations, nil
+}
+
+// HandleUpdateConversation 处理修改会话状态请求
+func HandleUpdateConversation(ctx context.Context, client message.MessageServiceClient, req *message.UpdateConversationRequest) (*message.UpdateConversationResponse, error) {
+	resp, err := client.UpdateConversation(ctx, req)
+	if err != nil {
+		log.Printf("修改会话状态失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleSetDisappearingTimer 处理设置会话阅后即焚时长请求
+func HandleSetDisappearingTimer(ctx context.Context, client message.MessageServiceClient, req *message.SetDisappearingTimerRequest) (*message.SetDisappearingTimerResponse, error) {
+	resp, err := client.SetDisappearingTimer(ctx, req)
+	if err != nil {
+		log.Printf("设置阅后即焚时长失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleMarkConversationRead 处理将会话标记为已读请求
+func HandleMarkConversationRead(ctx context.Context, client message.MessageServiceClient, req *message.MarkConversationReadRequest) (*message.MarkConversationReadResponse, error) {
+	resp, err := client.MarkConversationRead(ctx, req)
+	if err != nil {
+		log.Printf("标记会话已读失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleGetUnreadCounts 处理查询未读数请求，将各会话的未读数转换为协议中的格式
+func HandleGetUnreadCounts(ctx context.Context, client message.MessageServiceClient, req *message.GetUnreadCountsRequest) (*message.GetUnreadCountsResponse, []*protocol.ConversationUnread, error) {
+	resp, err := client.GetUnreadCounts(ctx, req)
+	if err != nil {
+		log.Printf("查询未读数失败: %v", err)
+		return nil, nil, err
+	}
+
+	conversations := make([]*protocol.ConversationUnread, 0, len(resp.Conversations))
+	for _, item := range resp.Conversations {
+		conversations = append(conversations, &protocol.ConversationUnread{
+			ConversationId: item.ConversationId,
+			UnreadCount:    item.UnreadCount,
+		})
+	}
+	return resp, conversations, nil
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/user"
+	"log"
+	"time"
+)
+
+// HandleUploadDeviceKeys 处理上传设备公钥请求
+func HandleUploadDeviceKeys(ctx context.Context, client user.UserServiceClient, req *user.UploadDeviceKeysRequest) (*user.UploadDeviceKeysResponse, error) {
+	resp, err := client.UploadDeviceKeys(ctx, req)
+	if err != nil {
+		log.Printf("上传设备公钥失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleGetIdentityKeys 处理查询身份公钥请求，将设备的身份公钥转换为协议中的格式
+func HandleGetIdentityKeys(ctx context.Context, client user.UserServiceClient, req *user.GetIdentityKeysRequest) (*user.GetIdentityKeysResponse, []*protocol.DeviceIdentityKey, error) {
+	resp, err := client.GetIdentityKeys(ctx, req)
+	if err != nil {
+		log.Printf("查询身份公钥失败: %v", err)
+		return nil, nil, err
+	}
+	devices := make([]*protocol.DeviceIdentityKey, 0, len(resp.Devices))
+	for _, device := range resp.Devices {
+		var updatedAt int64
+		if t, err := time.Parse(time.RFC3339, device.UpdatedAt); err == nil {
+			updatedAt = t.UnixMilli()
+		}
+		devices = append(devices, &protocol.DeviceIdentityKey{
+			DeviceId:       device.DeviceId,
+			RegistrationId: device.RegistrationId,
+			IdentityKey:    device.IdentityKey,
+			UpdatedAt:      updatedAt,
+		})
+	}
+	return resp, devices, nil
+}
+
+// HandleGetPrekeyBundles 处理获取预共享公钥包请求，将公钥包转换为协议中的格式
+func HandleGetPrekeyBundles(ctx context.Context, client user.UserServiceClient, req *user.GetPrekeyBundlesRequest) (*user.GetPrekeyBundlesResponse, []*protocol.PrekeyBundle, error) {
+	resp, err := client.GetPrekeyBundles(ctx, req)
+	if err != nil {
+		log.Printf("获取预共享公钥包失败: %v", err)
+		return nil, nil, err
+	}
+	bundles := make([]*protocol.PrekeyBundle, 0, len(resp.Bundles))
+	for _, bundle := range resp.Bundles {
+		item := &protocol.PrekeyBundle{
+			DeviceId:       bundle.DeviceId,
+			RegistrationId: bundle.RegistrationId,
+			IdentityKey:    bundle.IdentityKey,
+		}
+		if bundle.SignedPrekey != nil {
+			item.SignedPrekey = &protocol.SignedPrekey{
+				KeyId:     bundle.SignedPrekey.KeyId,
+				PublicKey: bundle.SignedPrekey.PublicKey,
+				Signature: bundle.SignedPrekey.Signature,
+			}
+		}
+		if bundle.OneTimePrekey != nil {
+			item.OneTimePrekey = &protocol.OneTimePrekey{
+				KeyId:     bundle.OneTimePrekey.KeyId,
+				PublicKey: bundle.OneTimePrekey.PublicKey,
+			}
+		}
+		bundles = append(bundles, item)
+	}
+	return resp, bundles, nil
+}
+
+// HandleGetPrekeyCount 处理查询剩余预共享公钥数请求
+func HandleGetPrekeyCount(ctx context.Context, client user.UserServiceClient, req *user.GetPrekeyCountRequest) (*user.GetPrekeyCountResponse, error) {
+	resp, err := client.GetPrekeyCount(ctx, req)
+	if err != nil {
+		log.Printf("查询剩余预共享公钥数失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// toUserSignedPrekey 将客户端上传的签名预共享公钥转换为用户服务的格式
+func toUserSignedPrekey(prekey *protocol.SignedPrekey) *user.SignedPrekey {
+	if prekey == nil {
+		return nil
+	}
+	return &user.SignedPrekey{
+		KeyId:     prekey.KeyId,
+		PublicKey: prekey.PublicKey,
+		Signature: prekey.Signature,
+	}
+}
+
+// toUserOneTimePrekeys 将客户端上传的一次性预共享公钥转换为用户服务的格式
+func toUserOneTimePrekeys(prekeys []*protocol.OneTimePrekey) []*user.OneTimePrekey {
+	items := make([]*user.OneTimePrekey, 0, len(prekeys))
+	for _, prekey := range prekeys {
+		items = append(items, &user.OneTimePrekey{
+			KeyId:     prekey.KeyId,
+			PublicKey: prekey.PublicKey,
+		})
+	}
+	return items
+}
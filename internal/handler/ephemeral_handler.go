@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/friend"
+	"im-service/internal/rpc/group"
+	"im-service/internal/svc"
+	"time"
+)
+
+const (
+	// ephemeralTTL 临时事件的有效期，超时未投递则丢弃
+	ephemeralTTL = 5 * time.Second
+	// ephemeralRateLimit 每个发送者在一个窗口内最多发送的临时事件数
+	ephemeralRateLimit = 5
+	// ephemeralRateWindow 临时事件限流窗口
+	ephemeralRateWindow = time.Second
+)
+
+var (
+	// ErrRateLimited 发送过于频繁
+	ErrRateLimited = errors.New("发送过于频繁")
+	// ErrEphemeralNotAllowed 对方不是好友，或发送者不是群成员
+	ErrEphemeralNotAllowed = errors.New("无权向对方发送临时事件")
+)
+
+// HandleEphemeral 处理输入状态等临时事件，经 Kafka 路由到接收方所在节点，不落库也不进入离线收件箱
+// 单聊只能发给未互相拉黑的好友，群聊只能由群成员发送
+func HandleEphemeral(ctx context.Context, sc *svc.ServiceContext, from string, req *protocol.EphemeralRequest) error {
+	allowed, err := sc.RedisClient.AllowRate(ctx, "ephemeral:"+from, ephemeralRateLimit, ephemeralRateWindow)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrRateLimited
+	}
+	if req.GroupId != "" {
+		return sendGroupEphemeral(ctx, sc, from, req)
+	}
+
+	if err := friend.CheckBlocked(ctx, sc.MongoClient, from, req.To); err != nil {
+		return err
+	}
+	isFriend, err := friend.IsFriends(ctx, sc.MongoClient, sc.RedisClient, from, req.To)
+	if err != nil {
+		return err
+	}
+	if !isFriend {
+		return ErrEphemeralNotAllowed
+	}
+	// 接收方不在线时没有必要发送
+	online, err := sc.RedisClient.IsOnline(ctx, req.To)
+	if err != nil || !online {
+		return err
+	}
+	return sc.KafkaProducer.SendEphemeral(from, req.To, "", req.Kind.String(), []string{req.To}, ephemeralTTL)
+}
+
+// sendGroupEphemeral 将群聊中的临时事件推送给其他群成员，不在线的成员在路由时跳过
+func sendGroupEphemeral(ctx context.Context, sc *svc.ServiceContext, from string, req *protocol.EphemeralRequest) error {
+	role, err := group.GetMemberRole(ctx, sc.MongoClient, req.GroupId, from)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return ErrEphemeralNotAllowed
+	}
+	members, err := group.ListMemberNames(ctx, sc.MongoClient, req.GroupId)
+	if err != nil {
+		return err
+	}
+	targets := make([]string, 0, len(members))
+	for _, member := range members {
+		if member != from {
+			targets = append(targets, member)
+		}
+	}
+	return sc.KafkaProducer.SendEphemeral(from, "", req.GroupId, req.Kind.String(), targets, ephemeralTTL)
+}
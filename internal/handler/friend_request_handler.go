@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/friend"
+	"im-service/internal/svc"
+	"log"
+	"time"
+)
+
+// dispatchFriendRequestFrame 处理好友请求相关的命令，userName 为连接绑定的用户
+func dispatchFriendRequestFrame(ctx context.Context, sc *svc.ServiceContext, userName string, frame *protocol.Frame) *protocol.Frame {
+	switch body := frame.Body.(type) {
+	case *protocol.Frame_SendFriendRequest:
+		if body.SendFriendRequest.To == "" || body.SendFriendRequest.To == userName {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "无效的接收者")
+		}
+		// 帧 ID 由客户端生成，重试时不变，作为幂等的请求 ID
+		result, err := sc.FriendClient.SendFriendRequest(ctx, &friend.FriendRequest{
+			From:            userName,
+			To:              body.SendFriendRequest.To,
+			ClientRequestId: frame.Id,
+		})
+		return friendRequestOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendRequestOperationResponse) {
+			resp.Body = &protocol.Frame_SendFriendRequestResponse{SendFriendRequestResponse: body}
+		})
+
+	case *protocol.Frame_AcceptFriendRequest:
+		if body.AcceptFriendRequest.From == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "发送者不能为空")
+		}
+		result, err := sc.FriendClient.AcceptFriendRequest(ctx, &friend.FriendRequest{
+			From:            body.AcceptFriendRequest.From,
+			To:              userName,
+			ClientRequestId: frame.Id,
+		})
+		return friendRequestOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendRequestOperationResponse) {
+			resp.Body = &protocol.Frame_AcceptFriendRequestResponse{AcceptFriendRequestResponse: body}
+		})
+
+	case *protocol.Frame_RejectFriendRequest:
+		if body.RejectFriendRequest.From == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "发送者不能为空")
+		}
+		result, err := sc.FriendClient.RejectFriendRequest(ctx, &friend.FriendRequest{
+			From: body.RejectFriendRequest.From,
+			To:   userName,
+		})
+		return friendRequestOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendRequestOperationResponse) {
+			resp.Body = &protocol.Frame_RejectFriendRequestResponse{RejectFriendRequestResponse: body}
+		})
+
+	case *protocol.Frame_CancelFriendRequest:
+		if body.CancelFriendRequest.To == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "接收者不能为空")
+		}
+		result, err := sc.FriendClient.CancelFriendRequest(ctx, &friend.FriendRequest{
+			From: userName,
+			To:   body.CancelFriendRequest.To,
+		})
+		return friendRequestOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.FriendRequestOperationResponse) {
+			resp.Body = &protocol.Frame_CancelFriendRequestResponse{CancelFriendRequestResponse: body}
+		})
+
+	case *protocol.Frame_ListIncomingFriendRequests:
+		result, err := sc.FriendClient.ListIncomingFriendRequests(ctx, toListFriendRequestsRequest(userName, body.ListIncomingFriendRequests))
+		return listFriendRequestsResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.ListFriendRequestsResponse) {
+			resp.Body = &protocol.Frame_ListIncomingFriendRequestsResponse{ListIncomingFriendRequestsResponse: body}
+		})
+
+	case *protocol.Frame_ListOutgoingFriendRequests:
+		result, err := sc.FriendClient.ListOutgoingFriendRequests(ctx, toListFriendRequestsRequest(userName, body.ListOutgoingFriendRequests))
+		return listFriendRequestsResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.ListFriendRequestsResponse) {
+			resp.Body = &protocol.Frame_ListOutgoingFriendRequestsResponse{ListOutgoingFriendRequestsResponse: body}
+		})
+
+	default:
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+}
+
+// isFriendRequestCommand 判断命令是否为好友请求相关的命令
+func isFriendRequestCommand(frame *protocol.Frame) bool {
+	switch frame.Body.(type) {
+	case *protocol.Frame_SendFriendRequest, *protocol.Frame_AcceptFriendRequest, *protocol.Frame_RejectFriendRequest,
+		*protocol.Frame_CancelFriendRequest, *protocol.Frame_ListIncomingFriendRequests, *protocol.Frame_ListOutgoingFriendRequests:
+		return true
+	}
+	return false
+}
+
+// friendRequestOperationResponse 将好友请求操作的结果转换为响应帧，setBody 负责填入对应的响应字段
+func friendRequestOperationResponse(frame *protocol.Frame, result *friend.FriendRequestResponse, err error, setBody func(*protocol.Frame, *protocol.FriendRequestOperationResponse)) *protocol.Frame {
+	if err != nil {
+		log.Printf("好友请求操作失败: %v", err)
+		return rpcError(frame, err)
+	}
+	if !result.Success {
+		return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+	}
+	resp := protocol.NewResponse(frame)
+	setBody(resp, &protocol.FriendRequestOperationResponse{})
+	return resp
+}
+
+// toListFriendRequestsRequest 将客户端的查询条件转换为好友服务的请求
+func toListFriendRequestsRequest(userName string, req *protocol.ListFriendRequestsRequest) *friend.ListFriendRequestsRequest {
+	statuses := make([]friend.FriendRequestStatus, 0, len(req.Statuses))
+	for _, status := range req.Statuses {
+		statuses = append(statuses, friend.FriendRequestStatus(status))
+	}
+	return &friend.ListFriendRequestsRequest{
+		Username: userName,
+		Statuses: statuses,
+		Limit:    req.Limit,
+		Before:   req.Before,
+	}
+}
+
+// listFriendRequestsResponse 将查询好友请求的结果转换为响应帧，setBody 负责填入对应的响应字段
+func listFriendRequestsResponse(frame *protocol.Frame, result *friend.ListFriendRequestsResponse, err error, setBody func(*protocol.Frame, *protocol.ListFriendRequestsResponse)) *protocol.Frame {
+	if err != nil {
+		log.Printf("查询好友请求失败: %v", err)
+		return rpcError(frame, err)
+	}
+	if !result.Success {
+		return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+	}
+	requests := make([]*protocol.FriendRequestItem, 0, len(result.Requests))
+	for _, item := range result.Requests {
+		request := &protocol.FriendRequestItem{
+			RequestId: item.RequestId,
+			From:      item.From,
+			To:        item.To,
+			Status:    protocol.FriendRequestStatus(item.Status),
+		}
+		if t, err := time.Parse(time.RFC3339, item.CreatedAt); err == nil {
+			request.CreatedAt = t.UnixMilli()
+		}
+		if t, err := time.Parse(time.RFC3339, item.UpdatedAt); err == nil {
+			request.UpdatedAt = t.UnixMilli()
+		}
+		requests = append(requests, request)
+	}
+	resp := protocol.NewResponse(frame)
+	setBody(resp, &protocol.ListFriendRequestsResponse{
+		Requests:   requests,
+		NextCursor: result.NextCursor,
+		HasMore:    result.HasMore,
+	})
+	return resp
+}
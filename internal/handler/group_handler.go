@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/group"
+	"im-service/internal/rpc/message"
+	"im-service/internal/svc"
+	"log"
+)
+
+// dispatchGroupFrame 处理群组相关的命令，userName 为连接绑定的用户
+func dispatchGroupFrame(ctx context.Context, sc *svc.ServiceContext, userName string, frame *protocol.Frame) *protocol.Frame {
+	switch body := frame.Body.(type) {
+	case *protocol.Frame_CreateGroup:
+		if body.CreateGroup.Name == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "群名称不能为空")
+		}
+		result, err := sc.GroupClient.CreateGroup(ctx, &group.CreateGroupRequest{
+			Owner:   userName,
+			Name:    body.CreateGroup.Name,
+			Members: body.CreateGroup.Members,
+			Public:  body.CreateGroup.Public,
+		})
+		if err != nil {
+			log.Printf("创建群组失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_CreateGroupResponse{CreateGroupResponse: &protocol.CreateGroupResponse{
+			GroupId: result.GroupId,
+		}}
+		return resp
+
+	case *protocol.Frame_InviteGroupMembers:
+		result, err := sc.GroupClient.InviteMembers(ctx, &group.InviteMembersRequest{
+			Username:  userName,
+			GroupId:   body.InviteGroupMembers.GroupId,
+			Usernames: body.InviteGroupMembers.Usernames,
+		})
+		return groupOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.GroupOperationResponse) {
+			resp.Body = &protocol.Frame_InviteGroupMembersResponse{InviteGroupMembersResponse: body}
+		})
+
+	case *protocol.Frame_JoinGroup:
+		result, err := sc.GroupClient.JoinGroup(ctx, &group.JoinGroupRequest{
+			Username: userName,
+			GroupId:  body.JoinGroup.GroupId,
+		})
+		return groupOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.GroupOperationResponse) {
+			resp.Body = &protocol.Frame_JoinGroupResponse{JoinGroupResponse: body}
+		})
+
+	case *protocol.Frame_LeaveGroup:
+		result, err := sc.GroupClient.LeaveGroup(ctx, &group.LeaveGroupRequest{
+			Username: userName,
+			GroupId:  body.LeaveGroup.GroupId,
+		})
+		return groupOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.GroupOperationResponse) {
+			resp.Body = &protocol.Frame_LeaveGroupResponse{LeaveGroupResponse: body}
+		})
+
+	case *protocol.Frame_KickGroupMember:
+		result, err := sc.GroupClient.KickMember(ctx, &group.KickMemberRequest{
+			Username: userName,
+			GroupId:  body.KickGroupMember.GroupId,
+			Target:   body.KickGroupMember.Target,
+		})
+		return groupOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.GroupOperationResponse) {
+			resp.Body = &protocol.Frame_KickGroupMemberResponse{KickGroupMemberResponse: body}
+		})
+
+	case *protocol.Frame_SetGroupMemberRole:
+		result, err := sc.GroupClient.SetMemberRole(ctx, &group.SetMemberRoleRequest{
+			Username: userName,
+			GroupId:  body.SetGroupMemberRole.GroupId,
+			Target:   body.SetGroupMemberRole.Target,
+			Role:     group.GroupRole(body.SetGroupMemberRole.Role),
+		})
+		return groupOperationResponse(frame, result, err, func(resp *protocol.Frame, body *protocol.GroupOperationResponse) {
+			resp.Body = &protocol.Frame_SetGroupMemberRoleResponse{SetGroupMemberRoleResponse: body}
+		})
+
+	case *protocol.Frame_GetGroupMembers:
+		result, err := sc.GroupClient.GetGroupMembers(ctx, &group.GetGroupMembersRequest{
+			Username: userName,
+			GroupId:  body.GetGroupMembers.GroupId,
+		})
+		if err != nil {
+			log.Printf("获取群成员失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		members := make([]*protocol.GroupMember, 0, len(result.Members))
+		for _, member := range result.Members {
+			members = append(members, &protocol.GroupMember{
+				Username: member.Username,
+				Role:     protocol.GroupRole(member.Role),
+			})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetGroupMembersResponse{GetGroupMembersResponse: &protocol.GetGroupMembersResponse{
+			Name:    result.Name,
+			Members: members,
+		}}
+		return resp
+
+	case *protocol.Frame_GetUserGroups:
+		result, err := sc.GroupClient.GetUserGroups(ctx, &group.GetUserGroupsRequest{Username: userName})
+		if err != nil {
+			log.Printf("获取群组列表失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		groups := make([]*protocol.GroupInfo, 0, len(result.Groups))
+		for _, info := range result.Groups {
+			groups = append(groups, &protocol.GroupInfo{
+				GroupId: info.GroupId,
+				Name:    info.Name,
+				Owner:   info.Owner,
+				Role:    protocol.GroupRole(info.Role),
+			})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetUserGroupsResponse{GetUserGroupsResponse: &protocol.GetUserGroupsResponse{
+			Groups: groups,
+		}}
+		return resp
+
+	case *protocol.Frame_SendGroupMessage:
+		if body.SendGroupMessage.GroupId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "群组不能为空")
+		}
+		result, err := sc.MessageClient.SendGroupMessage(ctx, &message.SendGroupMessageRequest{
+			From:          userName,
+			GroupId:       body.SendGroupMessage.GroupId,
+			Content:       body.SendGroupMessage.Content,
+			ClientMsgId:   body.SendGroupMessage.ClientMsgId,
+			Type:          message.MessageType(body.SendGroupMessage.Type),
+			AttachmentIds: body.SendGroupMessage.AttachmentIds,
+			Location:      toMessageLocation(body.SendGroupMessage.Location),
+		})
+		if err != nil {
+			log.Printf("发送群消息失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SendGroupMessageResponse{SendGroupMessageResponse: &protocol.SendGroupMessageResponse{
+			MessageId: result.MessageId,
+		}}
+		return resp
+
+	default:
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+}
+
+// isGroupCommand 判断命令是否为群组相关的命令
+func isGroupCommand(frame *protocol.Frame) bool {
+	switch frame.Body.(type) {
+	case *protocol.Frame_CreateGroup, *protocol.Frame_InviteGroupMembers, *protocol.Frame_JoinGroup,
+		*protocol.Frame_LeaveGroup, *protocol.Frame_KickGroupMember, *protocol.Frame_SetGroupMemberRole,
+		*protocol.Frame_GetGroupMembers, *protocol.Frame_GetUserGroups, *protocol.Frame_SendGroupMessage:
+		return true
+	}
+	return false
+}
+
+// groupOperationResponse 将群组操作的结果转换为响应帧，setBody 负责填入对应的响应字段
+func groupOperationResponse(frame *protocol.Frame, result *group.GroupResponse, err error, setBody func(*protocol.Frame, *protocol.GroupOperationResponse)) *protocol.Frame {
+	if err != nil {
+		log.Printf("群组操作失败: %v", err)
+		return rpcError(frame, err)
+	}
+	if !result.Success {
+		return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+	}
+	resp := protocol.NewResponse(frame)
+	setBody(resp, &protocol.GroupOperationResponse{})
+	return resp
+}
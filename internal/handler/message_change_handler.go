@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/rpc/message"
+	"log"
+)
+
+// HandleRecallMessage 处理撤回消息请求
+func HandleRecallMessage(ctx context.Context, client message.MessageServiceClient, req *message.RecallMessageRequest) (*message.RecallMessageResponse, error) {
+	resp, err := client.RecallMessage(ctx, req)
+	if err != nil {
+		log.Printf("撤回消息失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleEditMessage 处理编辑消息请求
+func HandleEditMessage(ctx context.Context, client message.MessageServiceClient, req *message.EditMessageRequest) (*message.EditMessageResponse, error) {
+	resp, err := client.EditMessage(ctx, req)
+	if err != nil {
+		log.Printf("编辑消息失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleDeleteMessage 处理删除消息请求
+func HandleDeleteMessage(ctx context.Context, client message.MessageServiceClient, req *message.DeleteMessageRequest) (*message.DeleteMessageResponse, error) {
+	resp, err := client.DeleteMessage(ctx, req)
+	if err != nil {
+		log.Printf("删除消息失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"im-service/internal/data/redis"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/message"
+	"im-service/internal/svc"
+	websocket2 "im-service/internal/websocket"
+	"log"
+	"time"
+)
+
+const (
+	// inboxReplayBatch 每次从收件箱读取的消息数，远小于连接的写缓冲
+	inboxReplayBatch = 100
+	// inboxReplayWait 补推时写缓冲已满的最长等待时间，超时后停止本次补推，下次连接时继续
+	inboxReplayWait = 10 * time.Second
+)
+
+// DeliverOfflineMessages 连接认证后按时间顺序补推离线收件箱中该设备尚未确认的消息
+// 消息在该设备 ack 之前一直保留，下次上线时会再次推送；分批读取，写缓冲满时等待客户端消费而不是断开连接
+func DeliverOfflineMessages(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection) {
+	userName := conn.Username()
+	if userName == "" {
+		return
+	}
+	deviceID := conn.DeviceID()
+	ids, err := sc.RedisClient.PendingInbox(ctx, userName, deviceID)
+	if err != nil {
+		log.Printf("读取用户 %s 的离线收件箱失败: %v", userName, err)
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+	log.Printf("向用户 %s 的设备 %s 补推 %d 条离线消息", userName, deviceID, len(ids))
+
+	var expired []string
+	// 过期消息在补推结束或中途停止时统一移除
+	defer func() {
+		if err := sc.RedisClient.RemoveInbox(ctx, userName, expired...); err != nil {
+			log.Printf("从用户 %s 的离线收件箱移除过期消息失败: %v", userName, err)
+		}
+	}()
+	for start := 0; start < len(ids); start += inboxReplayBatch {
+		end := min(start+inboxReplayBatch, len(ids))
+		items, err := sc.RedisClient.GetInboxItems(ctx, userName, ids[start:end])
+		if err != nil {
+			log.Printf("读取用户 %s 的离线消息失败: %v", userName, err)
+			return
+		}
+		now := time.Now()
+		for _, item := range items {
+			// 已过期的消息不再投递，清理任务运行前直接从收件箱移除
+			if (item.ExpireAt > 0 && item.ExpireAt <= now.UnixMilli()) ||
+				(sc.Config.Message.Retention > 0 && item.Timestamp <= now.Add(-sc.Config.Message.Retention).UnixMilli()) {
+				expired = append(expired, item.MessageID)
+				continue
+			}
+			if err := conn.WriteFrameWait(offlineMessageFrame(item), inboxReplayWait); err != nil {
+				log.Printf("向用户 %s 补推离线消息失败: %v", userName, err)
+				return
+			}
+		}
+	}
+}
+
+// offlineMessageFrame 将收件箱中的消息转换为 newMessage 推送
+func offlineMessageFrame(item *redis.InboxItem) *protocol.Frame {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_NewMessage{NewMessage: &protocol.NewMessageEvent{
+		MessageId:         item.MessageID,
+		From:              item.From,
+		To:                item.To,
+		Type:              protocol.MessageTypeFromModel(item.Type),
+		Content:           item.Content,
+		Attachments:       protocol.AttachmentsFromModel(item.Attachments),
+		Location:          protocol.LocationFromModel(item.Location),
+		Timestamp:         item.Timestamp,
+		Offline:           true,
+		ConversationId:    item.ConversationID,
+		Seq:               item.Seq,
+		ExpireAt:          item.ExpireAt,
+		EncryptedPayloads: protocol.EncryptedPayloadsFromModel(item.EncryptedPayloads),
+	}}
+	return frame
+}
+
+// HandleAck 处理客户端的消息确认：记录该设备已收到，之后不再向该设备补推，并通知消息服务标记为已送达
+func HandleAck(ctx context.Context, sc *svc.ServiceContext, userName, deviceID string, messageIDs []string) error {
+	if err := sc.RedisClient.AckInbox(ctx, userName, deviceID, messageIDs...); err != nil {
+		return err
+	}
+	resp, err := sc.MessageClient.AckMessages(ctx, &message.AckMessagesRequest{
+		Username:   userName,
+		MessageIds: messageIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return errors.New(resp.ErrorMsg)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"im-service/internal/middleware"
+	"im-service/internal/rpc/presence"
+	"im-service/internal/svc"
+	websocket2 "im-service/internal/websocket"
+	"log"
+	"time"
+)
+
+// presenceTimeout 连接建立和断开时更新在线状态的超时时间
+const presenceTimeout = 3 * time.Second
+
+// HandleSetPresence 更新当前连接对应设备的在线状态，返回用户的聚合状态
+func HandleSetPresence(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection, userName string, status presence.PresenceStatus) (presence.PresenceStatus, error) {
+	resp, err := sc.PresenceClient.SetDeviceStatus(ctx, &presence.SetDeviceStatusRequest{
+		Username: userName,
+		DeviceId: conn.ID,
+		NodeId:   sc.Config.NodeID,
+		Status:   status,
+	})
+	if err != nil {
+		return presence.PresenceStatus_OFFLINE, err
+	}
+	if !resp.Success {
+		return presence.PresenceStatus_OFFLINE, errors.New(resp.ErrorMsg)
+	}
+	return resp.Status, nil
+}
+
+// HandleSetPresenceHidden 隐藏或公开在线状态
+func HandleSetPresenceHidden(ctx context.Context, client presence.PresenceServiceClient, req *presence.SetPresenceHiddenRequest) (*presence.SetPresenceHiddenResponse, error) {
+	resp, err := client.SetPresenceHidden(ctx, req)
+	if err != nil {
+		log.Printf("设置隐藏在线状态失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleGetPresence 查询好友的在线状态
+func HandleGetPresence(ctx context.Context, client presence.PresenceServiceClient, req *presence.GetPresenceRequest) (*presence.GetPresenceResponse, error) {
+	resp, err := client.GetPresence(ctx, req)
+	if err != nil {
+		log.Printf("查询在线状态失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// updateDevicePresence 连接认证或断开时更新设备在线状态，失败只记录日志
+// 这是网关自身发起的调用，以服务身份认证，连接绑定的 token 过期后断开连接仍能将设备标记为离线
+func updateDevicePresence(sc *svc.ServiceContext, conn *websocket2.WebSocketConnection, userName string, status presence.PresenceStatus) {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+	if _, err := HandleSetPresence(middleware.WithServiceIdentity(ctx, userName), sc, conn, userName, status); err != nil {
+		log.Printf("更新用户 %s 的在线状态失败: %v", userName, err)
+	}
+}
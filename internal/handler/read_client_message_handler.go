@@ -2,95 +2,710 @@ package handler
 
 import (
 	"context"
-	"github.com/gorilla/websocket"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"im-service/internal/general"
+	"im-service/internal/protocol"
 	"im-service/internal/rpc/friend"
 	"im-service/internal/rpc/message"
+	"im-service/internal/rpc/presence"
 	"im-service/internal/rpc/user"
+	"im-service/internal/svc"
 	websocket2 "im-service/internal/websocket"
 	"log"
-	"strings"
+	"time"
 )
 
-func ReadClientMessages(ctx context.Context, conn *websocket.Conn, userClient user.UserServiceClient, messageClient message.MessageServiceClient, friendClient friend.FriendServiceClient) {
+func ReadClientMessages(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection) {
 
-	defer conn.Close()
+	// 连接断开时移除路由和连接，并停止写协程
+	defer func() {
+		if userName := conn.Username(); userName != "" {
+			unbindRoute(sc, userName, conn)
+		}
+		websocket2.Connections.Remove(conn)
+		conn.Close()
+	}()
 
 	for {
-		_, messageData, err := conn.ReadMessage()
+		// 每收到一帧就延长读超时，客户端长时间无任何帧视为断线
+		if err := conn.Conn.SetReadDeadline(time.Now().Add(general.HeartbeatTimeout)); err != nil {
+			log.Printf("设置读超时失败: %v", err)
+			break
+		}
+		_, messageData, err := conn.Conn.ReadMessage()
 		if err != nil {
 			log.Printf("读取客户端消息失败: %v", err)
 			break
 		}
-		// 解析消息，假设消息格式为 "command|param1|param2..."
-		parts := strings.SplitN(string(messageData), "|", -1)
-		if len(parts) == 0 {
+
+		frame := &protocol.Frame{}
+		if err := conn.Codec.Unmarshal(messageData, frame); err != nil {
+			log.Printf("解析客户端帧失败: %v", err)
+			writeFrame(conn, protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "无法解析的帧"))
 			continue
 		}
-		command := parts[0]
-		switch command {
-		case "register":
-			if len(parts) == 4 {
-				username, password, nickname := parts[1], parts[2], parts[3]
-				req := &user.UserRegisterRequest{
-					Username: username,
-					Password: password,
-					Nickname: nickname,
-				}
-				resp, err := HandleUserRegister(ctx, userClient, req)
-				if err != nil {
-					log.Printf("用户注册失败: %v", err)
-				} else {
-					log.Printf("注册结果: %v", resp)
-				}
-			}
-		case "login":
-			if len(parts) == 3 {
-				username, password := parts[1], parts[2]
-				req := &user.UserLoginRequest{
-					Username: username,
-					Password: password,
-				}
-				resp, err := HandleUserLogin(ctx, userClient, req, conn)
-				if err != nil {
-					log.Printf("用户登录失败: %v", err)
-				} else {
-					log.Printf("登录结果: %v", resp)
-					// 用户登录成功后注册消息监听器
-					websocket2.RegisterMessageListener(username, func(from, to, message string) {
-						log.Printf("用户 %s 收到来自 %s 的消息: %s", to, from, message)
-					})
-				}
-			}
-		case "sendMessage":
-			if len(parts) == 4 {
-				from, to, content := parts[1], parts[2], parts[3]
-				req := &message.SendMessageRequest{
-					From:    from,
-					To:      to,
-					Content: content,
-				}
-				resp, err := HandleSendMessage(ctx, messageClient, req, conn)
-				if err != nil {
-					log.Printf("发送消息失败: %v", err)
-				} else {
-					log.Printf("发送消息结果\n: %v", resp)
-				}
+		if frame.Version != protocol.Version {
+			writeFrame(conn, protocol.NewError(frame, protocol.ErrorCode_UNSUPPORTED_VERSION, "不支持的协议版本"))
+			continue
+		}
+
+		previous := conn.Username()
+		resp := dispatchFrame(ctx, sc, conn, frame)
+		writeFrame(conn, resp)
+
+		// 连接刚完成认证或切换了账号，在响应之后补推离线消息
+		if current := conn.Username(); current != "" && current != previous {
+			DeliverOfflineMessages(ctx, sc, conn)
+		}
+	}
+}
+
+// dispatchFrame 根据帧类型调用对应的处理函数，返回需要写回客户端的响应帧
+func dispatchFrame(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection, frame *protocol.Frame) *protocol.Frame {
+	if frame.Body == nil {
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+	// 未认证的连接只能执行公开命令，其余命令的调用者一律取自连接绑定的身份
+	userName, token, authenticated := conn.Identity()
+	if !authenticated && !isPublicCommand(frame) {
+		return protocol.NewError(frame, protocol.ErrorCode_UNAUTHENTICATED, "请先登录")
+	}
+	// token 过期后只允许通过 login 或 auth 刷新，WatchSessionExpiry 稍后会关闭未刷新的连接
+	if authenticated && conn.Expired(time.Now()) && !isPublicCommand(frame) {
+		return protocol.NewError(frame, protocol.ErrorCode_UNAUTHENTICATED, "登录已过期，请重新认证")
+	}
+	if authenticated {
+		ctx = authContext(ctx, token)
+	}
+	if isGroupCommand(frame) {
+		return dispatchGroupFrame(ctx, sc, userName, frame)
+	}
+	if isAttachmentCommand(frame) {
+		return dispatchAttachmentFrame(ctx, sc, userName, frame)
+	}
+	if isFriendRequestCommand(frame) {
+		return dispatchFriendRequestFrame(ctx, sc, userName, frame)
+	}
+	if isContactCommand(frame) {
+		return dispatchContactFrame(ctx, sc, userName, frame)
+	}
+
+	switch body := frame.Body.(type) {
+	case *protocol.Frame_Heartbeat:
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_Heartbeat{Heartbeat: &protocol.Heartbeat{}}
+		return resp
+
+	case *protocol.Frame_Register:
+		if body.Register.Username == "" || body.Register.Password == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "用户名和密码不能为空")
+		}
+		req := &user.UserRegisterRequest{
+			Username: body.Register.Username,
+			Password: body.Register.Password,
+			Nickname: body.Register.Nickname,
+		}
+		result, err := HandleUserRegister(ctx, sc.UserClient, req)
+		if err != nil {
+			log.Printf("用户注册失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_RegisterResponse{RegisterResponse: &protocol.RegisterResponse{}}
+		return resp
+
+	case *protocol.Frame_Login:
+		if body.Login.Username == "" || body.Login.Password == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "用户名和密码不能为空")
+		}
+		req := &user.UserLoginRequest{
+			Username: body.Login.Username,
+			Password: body.Login.Password,
+		}
+		conn.SetDeviceID(body.Login.DeviceId)
+		result, err := HandleUserLogin(ctx, sc, req, conn)
+		if err != nil {
+			log.Printf("用户登录失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if result.ErrorMsg != "" {
+			return protocol.NewError(frame, protocol.ErrorCode_UNAUTHENTICATED, result.ErrorMsg)
+		}
+		// 用户登录成功后注册消息监听器
+		websocket2.RegisterMessageListener(req.Username, func(from, to, message string) {
+			log.Printf("用户 %s 收到来自 %s 的消息: %s", to, from, message)
+		})
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_LoginResponse{LoginResponse: &protocol.LoginResponse{
+			Username: req.Username,
+			Token:    result.Token,
+		}}
+		return resp
+
+	case *protocol.Frame_Auth:
+		if body.Auth.Token == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "token 不能为空")
+		}
+		conn.SetDeviceID(body.Auth.DeviceId)
+		authUser, err := HandleAuth(ctx, sc, conn, body.Auth.Token)
+		if err != nil {
+			return protocol.NewError(frame, protocol.ErrorCode_UNAUTHENTICATED, err.Error())
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_AuthResponse{AuthResponse: &protocol.AuthResponse{Username: authUser}}
+		return resp
+
+	case *protocol.Frame_SendMessage:
+		// 内容是否为空取决于消息类型，由消息服务校验
+		if body.SendMessage.To == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "接收者不能为空")
+		}
+		req := &message.SendMessageRequest{
+			From:              userName,
+			To:                body.SendMessage.To,
+			Content:           body.SendMessage.Content,
+			ClientMsgId:       body.SendMessage.ClientMsgId,
+			Type:              message.MessageType(body.SendMessage.Type),
+			AttachmentIds:     body.SendMessage.AttachmentIds,
+			Location:          toMessageLocation(body.SendMessage.Location),
+			EncryptedPayloads: toMessageEncryptedPayloads(body.SendMessage.EncryptedPayloads),
+		}
+		result, err := HandleSendMessage(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SendMessageResponse{SendMessageResponse: &protocol.SendMessageResponse{
+			MessageId: result.MessageId,
+		}}
+		return resp
+
+	case *protocol.Frame_Ack:
+		if len(body.Ack.MessageIds) == 0 {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "消息 ID 不能为空")
+		}
+		if err := HandleAck(ctx, sc, userName, conn.DeviceID(), body.Ack.MessageIds); err != nil {
+			log.Printf("确认消息失败: %v", err)
+			return protocol.NewError(frame, protocol.ErrorCode_INTERNAL, "确认消息失败")
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_AckResponse{AckResponse: &protocol.AckResponse{}}
+		return resp
+
+	case *protocol.Frame_Read:
+		if body.Read.Peer == "" || body.Read.UpToMessageId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话和消息 ID 不能为空")
+		}
+		req := &message.MarkReadRequest{
+			Username:      userName,
+			Peer:          body.Read.Peer,
+			UpToMessageId: body.Read.UpToMessageId,
+		}
+		result, err := HandleMarkRead(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_ReadResponse{ReadResponse: &protocol.ReadResponse{}}
+		return resp
+
+	case *protocol.Frame_GetReceipts:
+		if body.GetReceipts.Peer == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话不能为空")
+		}
+		req := &message.GetReceiptsRequest{
+			Username: userName,
+			Peer:     body.GetReceipts.Peer,
+			Limit:    body.GetReceipts.Limit,
+		}
+		result, err := HandleGetReceipts(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if result.ErrorMsg != "" {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		receipts := make([]*protocol.Receipt, 0, len(result.Receipts))
+		for _, item := range result.Receipts {
+			receipts = append(receipts, &protocol.Receipt{
+				MessageId: item.MessageId,
+				Status:    protocol.ReceiptStatus(item.Status),
+			})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetReceiptsResponse{GetReceiptsResponse: &protocol.GetReceiptsResponse{
+			Receipts:               receipts,
+			LastDeliveredMessageId: result.LastDeliveredMessageId,
+			LastReadMessageId:      result.LastReadMessageId,
+		}}
+		return resp
+
+	case *protocol.Frame_SyncMessages:
+		if body.SyncMessages.ConversationId == "" || body.SyncMessages.SinceSeq < 0 {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话不能为空且序列号不能为负")
+		}
+		req := &message.SyncMessagesRequest{
+			Username:       userName,
+			ConversationId: body.SyncMessages.ConversationId,
+			SinceSeq:       body.SyncMessages.SinceSeq,
+			Limit:          body.SyncMessages.Limit,
+		}
+		result, events, err := HandleSyncMessages(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if result.ErrorMsg != "" {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SyncMessagesResponse{SyncMessagesResponse: &protocol.SyncMessagesResponse{
+			Messages:  events,
+			LatestSeq: result.LatestSeq,
+			HasMore:   result.HasMore,
+		}}
+		return resp
+
+	case *protocol.Frame_RecallMessage:
+		if body.RecallMessage.MessageId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "消息 ID 不能为空")
+		}
+		req := &message.RecallMessageRequest{
+			Username:  userName,
+			MessageId: body.RecallMessage.MessageId,
+		}
+		result, err := HandleRecallMessage(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_RecallMessageResponse{RecallMessageResponse: &protocol.RecallMessageResponse{}}
+		return resp
+
+	case *protocol.Frame_EditMessage:
+		if body.EditMessage.MessageId == "" || body.EditMessage.Content == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "消息 ID 和内容不能为空")
+		}
+		req := &message.EditMessageRequest{
+			Username:  userName,
+			MessageId: body.EditMessage.MessageId,
+			Content:   body.EditMessage.Content,
+		}
+		result, err := HandleEditMessage(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		var editedAt int64
+		if t, err := time.Parse(time.RFC3339, result.EditedAt); err == nil {
+			editedAt = t.UnixMilli()
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_EditMessageResponse{EditMessageResponse: &protocol.EditMessageResponse{
+			EditedAt: editedAt,
+		}}
+		return resp
+
+	case *protocol.Frame_DeleteMessage:
+		if body.DeleteMessage.MessageId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "消息 ID 不能为空")
+		}
+		req := &message.DeleteMessageRequest{
+			Username:  userName,
+			MessageId: body.DeleteMessage.MessageId,
+		}
+		result, err := HandleDeleteMessage(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_DeleteMessageResponse{DeleteMessageResponse: &protocol.DeleteMessageResponse{}}
+		return resp
+
+	case *protocol.Frame_SearchMessages:
+		if body.SearchMessages.Query == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "搜索关键词不能为空")
+		}
+		types := make([]message.MessageType, 0, len(body.SearchMessages.Types))
+		for _, messageType := range body.SearchMessages.Types {
+			types = append(types, message.MessageType(messageType))
+		}
+		req := &message.SearchMessagesRequest{
+			Username:  userName,
+			Query:     body.SearchMessages.Query,
+			Peer:      body.SearchMessages.Peer,
+			GroupId:   body.SearchMessages.GroupId,
+			StartTime: formatSearchTime(body.SearchMessages.StartTime),
+			EndTime:   formatSearchTime(body.SearchMessages.EndTime),
+			Types:     types,
+			Limit:     body.SearchMessages.Limit,
+			Before:    body.SearchMessages.Before,
+		}
+		result, results, err := HandleSearchMessages(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if result.ErrorMsg != "" {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SearchMessagesResponse{SearchMessagesResponse: &protocol.SearchMessagesResponse{
+			Results:    results,
+			NextCursor: result.NextCursor,
+			HasMore:    result.HasMore,
+		}}
+		return resp
+
+	case *protocol.Frame_GetConversations:
+		req := &message.GetConversationsRequest{
+			Username: userName,
+			Archived: body.GetConversations.Archived,
+			Limit:    body.GetConversations.Limit,
+			Before:   body.GetConversations.Before,
+		}
+		result, conversations, err := HandleGetConversations(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if result.ErrorMsg != "" {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetConversationsResponse{GetConversationsResponse: &protocol.GetConversationsResponse{
+			Conversations: conversations,
+			NextCursor:    result.NextCursor,
+			HasMore:       result.HasMore,
+		}}
+		return resp
+
+	case *protocol.Frame_UpdateConversation:
+		if body.UpdateConversation.ConversationId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话不能为空")
+		}
+		req := &message.UpdateConversationRequest{
+			Username:       userName,
+			ConversationId: body.UpdateConversation.ConversationId,
+			Pinned:         body.UpdateConversation.Pinned,
+			Muted:          body.UpdateConversation.Muted,
+			Archived:       body.UpdateConversation.Archived,
+		}
+		result, err := HandleUpdateConversation(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_UpdateConversationResponse{UpdateConversationResponse: &protocol.UpdateConversationResponse{}}
+		return resp
+
+	case *protocol.Frame_SetDisappearingTimer:
+		if body.SetDisappearingTimer.ConversationId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话不能为空")
+		}
+		req := &message.SetDisappearingTimerRequest{
+			Username:       userName,
+			ConversationId: body.SetDisappearingTimer.ConversationId,
+			Seconds:        body.SetDisappearingTimer.Seconds,
+		}
+		result, err := HandleSetDisappearingTimer(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SetDisappearingTimerResponse{SetDisappearingTimerResponse: &protocol.SetDisappearingTimerResponse{}}
+		return resp
+
+	case *protocol.Frame_UploadDeviceKeys:
+		// 公钥格式由用户服务校验，服务端不解析公钥内容
+		if body.UploadDeviceKeys.DeviceId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "设备 ID 不能为空")
+		}
+		req := &user.UploadDeviceKeysRequest{
+			Username:       userName,
+			DeviceId:       body.UploadDeviceKeys.DeviceId,
+			RegistrationId: body.UploadDeviceKeys.RegistrationId,
+			IdentityKey:    body.UploadDeviceKeys.IdentityKey,
+			SignedPrekey:   toUserSignedPrekey(body.UploadDeviceKeys.SignedPrekey),
+			OneTimePrekeys: toUserOneTimePrekeys(body.UploadDeviceKeys.OneTimePrekeys),
+		}
+		result, err := HandleUploadDeviceKeys(ctx, sc.UserClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_UploadDeviceKeysResponse{UploadDeviceKeysResponse: &protocol.UploadDeviceKeysResponse{
+			RemainingPrekeys: result.RemainingPrekeys,
+			PrekeysLow:       result.PrekeysLow,
+		}}
+		return resp
+
+	case *protocol.Frame_GetIdentityKeys:
+		if body.GetIdentityKeys.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "用户不能为空")
+		}
+		req := &user.GetIdentityKeysRequest{
+			Username: userName,
+			Target:   body.GetIdentityKeys.Target,
+		}
+		result, devices, err := HandleGetIdentityKeys(ctx, sc.UserClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetIdentityKeysResponse{GetIdentityKeysResponse: &protocol.GetIdentityKeysResponse{
+			Devices: devices,
+		}}
+		return resp
+
+	case *protocol.Frame_GetPrekeyBundles:
+		if body.GetPrekeyBundles.Target == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "用户不能为空")
+		}
+		req := &user.GetPrekeyBundlesRequest{
+			Username: userName,
+			Target:   body.GetPrekeyBundles.Target,
+			DeviceId: body.GetPrekeyBundles.DeviceId,
+		}
+		result, bundles, err := HandleGetPrekeyBundles(ctx, sc.UserClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetPrekeyBundlesResponse{GetPrekeyBundlesResponse: &protocol.GetPrekeyBundlesResponse{
+			Bundles: bundles,
+		}}
+		return resp
+
+	case *protocol.Frame_GetPrekeyCount:
+		if body.GetPrekeyCount.DeviceId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "设备 ID 不能为空")
+		}
+		req := &user.GetPrekeyCountRequest{
+			Username: userName,
+			DeviceId: body.GetPrekeyCount.DeviceId,
+		}
+		result, err := HandleGetPrekeyCount(ctx, sc.UserClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetPrekeyCountResponse{GetPrekeyCountResponse: &protocol.GetPrekeyCountResponse{
+			RemainingPrekeys: result.RemainingPrekeys,
+			PrekeysLow:       result.PrekeysLow,
+		}}
+		return resp
+
+	case *protocol.Frame_MarkConversationRead:
+		if body.MarkConversationRead.ConversationId == "" || body.MarkConversationRead.UpToMessageId == "" {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "会话和消息ID不能为空")
+		}
+		req := &message.MarkConversationReadRequest{
+			Username:       userName,
+			ConversationId: body.MarkConversationRead.ConversationId,
+			UpToMessageId:  body.MarkConversationRead.UpToMessageId,
+		}
+		result, err := HandleMarkConversationRead(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_MarkConversationReadResponse{MarkConversationReadResponse: &protocol.MarkConversationReadResponse{
+			UnreadCount: result.UnreadCount,
+			TotalUnread: result.TotalUnread,
+		}}
+		return resp
+
+	case *protocol.Frame_GetUnreadCounts:
+		req := &message.GetUnreadCountsRequest{
+			Username: userName,
+			Rebuild:  body.GetUnreadCounts.Rebuild,
+		}
+		result, conversations, err := HandleGetUnreadCounts(ctx, sc.MessageClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetUnreadCountsResponse{GetUnreadCountsResponse: &protocol.GetUnreadCountsResponse{
+			TotalUnread:   result.TotalUnread,
+			Conversations: conversations,
+		}}
+		return resp
+
+	case *protocol.Frame_Ephemeral:
+		if body.Ephemeral.GroupId == "" && (body.Ephemeral.To == "" || body.Ephemeral.To == userName) {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "接收者无效")
+		}
+		if err := HandleEphemeral(ctx, sc, userName, body.Ephemeral); err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				return protocol.NewError(frame, protocol.ErrorCode_RATE_LIMITED, err.Error())
 			}
-		case "getFriendList":
-			if len(parts) == 2 {
-				userName := parts[1]
-				req := &friend.GetFriendListRequest{
-					Username: userName,
-				}
-				resp, err := GetFriendListHandler(ctx, friendClient, req)
-				if err != nil {
-					log.Printf("获得好友列表失败: %v", err)
-				} else {
-					log.Printf("好友列表\n: %v", resp)
-				}
+			if errors.Is(err, ErrEphemeralNotAllowed) || errors.Is(err, friend.ErrBlockedByYou) || errors.Is(err, friend.ErrUnavailable) {
+				return protocol.NewError(frame, protocol.ErrorCode_PERMISSION_DENIED, err.Error())
 			}
-		default:
-			log.Printf("未知命令: %s", command)
+			log.Printf("发送临时事件失败: %v", err)
+			return protocol.NewError(frame, protocol.ErrorCode_INTERNAL, "发送临时事件失败")
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_EphemeralResponse{EphemeralResponse: &protocol.EphemeralResponse{}}
+		return resp
+
+	case *protocol.Frame_SetPresence:
+		status := body.SetPresence.Status
+		if status != protocol.PresenceStatus_ONLINE && status != protocol.PresenceStatus_AWAY {
+			return protocol.NewError(frame, protocol.ErrorCode_BAD_REQUEST, "只能设置为在线或离开")
+		}
+		aggregate, err := HandleSetPresence(ctx, sc, conn, userName, presence.PresenceStatus(status))
+		if err != nil {
+			log.Printf("设置在线状态失败: %v", err)
+			return rpcError(frame, err)
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SetPresenceResponse{SetPresenceResponse: &protocol.SetPresenceResponse{
+			Status: protocol.PresenceStatus(aggregate),
+		}}
+		return resp
+
+	case *protocol.Frame_SetPresenceHidden:
+		req := &presence.SetPresenceHiddenRequest{
+			Username: userName,
+			Hidden:   body.SetPresenceHidden.Hidden,
+		}
+		result, err := HandleSetPresenceHidden(ctx, sc.PresenceClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
 		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_SetPresenceHiddenResponse{SetPresenceHiddenResponse: &protocol.SetPresenceHiddenResponse{}}
+		return resp
+
+	case *protocol.Frame_GetPresence:
+		req := &presence.GetPresenceRequest{
+			Username:  userName,
+			Usernames: body.GetPresence.Usernames,
+		}
+		result, err := HandleGetPresence(ctx, sc.PresenceClient, req)
+		if err != nil {
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		presences := make([]*protocol.UserPresence, 0, len(result.Presences))
+		for _, item := range result.Presences {
+			presences = append(presences, &protocol.UserPresence{
+				Username: item.Username,
+				Status:   protocol.PresenceStatus(item.Status),
+				LastSeen: item.LastSeen,
+			})
+		}
+		resp := protocol.NewResponse(frame)
+		resp.Body = &protocol.Frame_GetPresenceResponse{GetPresenceResponse: &protocol.GetPresenceResponse{
+			Presences: presences,
+		}}
+		return resp
+
+	case *protocol.Frame_GetFriendList:
+		req := &friend.GetFriendListRequest{
+			Username: userName,
+			Tag:      body.GetFriendList.Tag,
+			Sort:     friend.FriendListSort(body.GetFriendList.Sort),
+		}
+		result, err := GetFriendListHandler(ctx, sc.FriendClient, req)
+		if err != nil {
+			log.Printf("获得好友列表失败: %v", err)
+			return rpcError(frame, err)
+		}
+		if !result.Success {
+			return protocol.NewError(frame, protocol.ErrorCode_REQUEST_FAILED, result.ErrorMsg)
+		}
+		resp := protocol.NewResponse(frame)
+		friends := make([]*protocol.FriendEntry, 0, len(result.Friends))
+		for _, item := range result.Friends {
+			friends = append(friends, toFriendEntry(item))
+		}
+		resp.Body = &protocol.Frame_GetFriendListResponse{GetFriendListResponse: &protocol.GetFriendListResponse{
+			FriendUsernames: result.FriendUsernames,
+			Friends:         friends,
+		}}
+		return resp
+
+	default:
+		log.Printf("未知命令: %T", frame.Body)
+		return protocol.NewError(frame, protocol.ErrorCode_UNKNOWN_COMMAND, "未知命令")
+	}
+}
+
+// isPublicCommand 判断命令是否允许在未认证时执行
+func isPublicCommand(frame *protocol.Frame) bool {
+	switch frame.Body.(type) {
+	case *protocol.Frame_Heartbeat, *protocol.Frame_Register, *protocol.Frame_Login, *protocol.Frame_Auth:
+		return true
+	}
+	return false
+}
+
+// rpcError 将 gRPC 调用错误转换为错误帧
+func rpcError(req *protocol.Frame, err error) *protocol.Frame {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.Unauthenticated:
+		return protocol.NewError(req, protocol.ErrorCode_UNAUTHENTICATED, st.Message())
+	case codes.PermissionDenied:
+		return protocol.NewError(req, protocol.ErrorCode_PERMISSION_DENIED, st.Message())
+	case codes.InvalidArgument:
+		return protocol.NewError(req, protocol.ErrorCode_BAD_REQUEST, st.Message())
+	default:
+		return protocol.NewError(req, protocol.ErrorCode_INTERNAL, st.Message())
+	}
+}
+
+// writeFrame 向客户端写入一帧，失败只记录日志，由读循环感知连接断开
+func writeFrame(conn *websocket2.WebSocketConnection, frame *protocol.Frame) {
+	if err := conn.WriteFrame(frame); err != nil {
+		log.Printf("向客户端发送响应失败: %v", err)
 	}
 }
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/rpc/message"
+	"log"
+)
+
+// HandleMarkRead 处理标记已读请求
+func HandleMarkRead(ctx context.Context, client message.MessageServiceClient, req *message.MarkReadRequest) (*message.MarkReadResponse, error) {
+	resp, err := client.MarkRead(ctx, req)
+	if err != nil {
+		log.Printf("标记已读失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleGetReceipts 处理查询回执请求
+func HandleGetReceipts(ctx context.Context, client message.MessageServiceClient, req *message.GetReceiptsRequest) (*message.GetReceiptsResponse, error) {
+	resp, err := client.GetReceipts(ctx, req)
+	if err != nil {
+		log.Printf("查询回执失败: %v", err)
+		return nil, err
+	}
+	return resp, nil
+}
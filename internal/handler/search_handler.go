@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/message"
+	"log"
+	"time"
+)
+
+// HandleSearchMessages 处理搜索消息请求，将结果中的消息转换为与新消息推送相同的格式
+func HandleSearchMessages(ctx context.Context, client message.MessageServiceClient, req *message.SearchMessagesRequest) (*message.SearchMessagesResponse, []*protocol.SearchResult, error) {
+	resp, err := client.SearchMessages(ctx, req)
+	if err != nil {
+		log.Printf("搜索消息失败: %v", err)
+		return nil, nil, err
+	}
+
+	results := make([]*protocol.SearchResult, 0, len(resp.Results))
+	for _, item := range resp.Results {
+		highlights := make([]*protocol.SearchHighlight, 0, len(item.Highlights))
+		for _, h := range item.Highlights {
+			highlights = append(highlights, &protocol.SearchHighlight{
+				Offset: h.Offset,
+				Length: h.Length,
+			})
+		}
+		results = append(results, &protocol.SearchResult{
+			Message:    toNewMessageEvent(item.Message),
+			Snippet:    item.Snippet,
+			Highlights: highlights,
+		})
+	}
+	return resp, results, nil
+}
+
+// formatSearchTime 将毫秒时间戳转换为消息服务使用的时间格式，0 表示不限制
+func formatSearchTime(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339Nano)
+}
@@ -2,34 +2,52 @@ package handler
 
 import (
 	"context"
-	"fmt"
-	"github.com/gorilla/websocket"
+	"im-service/internal/protocol"
 	"im-service/internal/rpc/message"
 	"log"
 )
 
 // HandleSendMessage  处理发送消息请求
-func HandleSendMessage(ctx context.Context, client message.MessageServiceClient, req *message.SendMessageRequest, conn *websocket.Conn) (*message.SendMessageResponse, error) {
+func HandleSendMessage(ctx context.Context, client message.MessageServiceClient, req *message.SendMessageRequest) (*message.SendMessageResponse, error) {
 	// 发送消息前记录日志
-	log.Printf("准备发送消息: 从 %s 到 %s，内容: %s", req.From, req.To, req.Content)
+	log.Printf("准备发送消息: 从 %s 到 %s，类型: %s，内容: %s", req.From, req.To, req.Type, req.Content)
 
 	resp, err := client.SendMessage(ctx, req)
 	if err != nil {
-		// 发送失败，记录错误日志并发送错误消息给客户端
 		log.Printf("发送消息失败: %v", err)
-		errMsg := fmt.Sprintf("发送消息失败: %v", err)
-		if err := conn.WriteMessage(websocket.TextMessage, []byte(errMsg)); err != nil {
-			log.Printf("向客户端发送消息失败: %v", err)
-		}
 		return nil, err
 	}
 
-	// 发送成功，记录日志并发送成功消息给客户端
 	log.Printf("发送消息成功: %v", resp)
-	successMsg := fmt.Sprintf("发送消息成功: %v", resp)
-	if err := conn.WriteMessage(websocket.TextMessage, []byte(successMsg)); err != nil {
-		log.Printf("向客户端发送成功消息失败: %v", err)
+	return resp, nil
+}
+
+// toMessageLocation 将客户端发送的位置转换为消息服务的位置
+func toMessageLocation(location *protocol.MessageLocation) *message.Location {
+	if location == nil {
+		return nil
+	}
+	return &message.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Name:      location.Name,
+		Address:   location.Address,
 	}
+}
 
-	return resp, nil
+// toMessageEncryptedPayloads 将客户端发送的密文转换为消息服务的密文
+func toMessageEncryptedPayloads(payloads []*protocol.EncryptedPayload) []*message.EncryptedPayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+	items := make([]*message.EncryptedPayload, 0, len(payloads))
+	for _, p := range payloads {
+		items = append(items, &message.EncryptedPayload{
+			Recipient:  p.Recipient,
+			DeviceId:   p.DeviceId,
+			Type:       p.Type,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+	return items
 }
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/metadata"
+	"im-service/internal/middleware"
+	"im-service/internal/rpc/presence"
+	"im-service/internal/svc"
+	websocket2 "im-service/internal/websocket"
+	"log"
+	"strings"
+	"time"
+)
+
+// sessionCheckInterval 检查连接绑定的 token 是否过期的间隔
+const sessionCheckInterval = 30 * time.Second
+
+// BindSession 将认证后的用户身份绑定到连接，并在路由表中记录连接所在的网关节点
+func BindSession(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection, userName, token string) {
+	// 同一连接切换账号时，先移除旧账号的路由
+	if previous := conn.Username(); previous != "" && previous != userName {
+		unbindRoute(sc, previous, conn)
+	}
+	// token 在此之前已校验，这里只取出过期时间
+	_, expiresAt, err := middleware.ParseToken(token)
+	if err != nil {
+		log.Printf("解析用户 %s 的 token 失败: %v", userName, err)
+	}
+	websocket2.Connections.Bind(userName, token, expiresAt, conn)
+	if err := sc.RedisClient.BindRoute(ctx, userName, conn.ID, sc.Config.NodeID); err != nil {
+		log.Printf("写入用户 %s 的路由失败: %v", userName, err)
+	}
+	updateDevicePresence(sc, conn, userName, presence.PresenceStatus_ONLINE)
+}
+
+// WatchSessionExpiry 定期检查连接绑定的 token，过期且未通过 auth 命令刷新时关闭连接，连接关闭时退出
+// 关闭后由读循环移除路由并将设备标记为离线，客户端需要使用新 token 重新连接
+func WatchSessionExpiry(conn *websocket2.WebSocketConnection) {
+	ticker := time.NewTicker(sessionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-conn.Done():
+			return
+		}
+		if conn.Expired(time.Now()) {
+			log.Printf("连接 %s 的 token 已过期，关闭连接", conn.ID)
+			conn.CloseWithReason(websocket.ClosePolicyViolation, "token expired")
+			return
+		}
+	}
+}
+
+// HandleAuth 使用已有 token 认证连接，返回 token 中的用户名
+func HandleAuth(ctx context.Context, sc *svc.ServiceContext, conn *websocket2.WebSocketConnection, token string) (string, error) {
+	token = strings.TrimPrefix(token, "Bearer ")
+	userName, err := middleware.ValidateToken(token)
+	if err != nil {
+		return "", err
+	}
+	BindSession(ctx, sc, conn, userName, token)
+	log.Printf("用户 %s 通过 token 认证连接", userName)
+	return userName, nil
+}
+
+// unbindRoute 从路由表中移除连接，并将对应设备标记为离线
+func unbindRoute(sc *svc.ServiceContext, userName string, conn *websocket2.WebSocketConnection) {
+	if err := sc.RedisClient.UnbindRoute(context.Background(), userName, conn.ID); err != nil {
+		log.Printf("移除用户 %s 的路由失败: %v", userName, err)
+	}
+	updateDevicePresence(sc, conn, userName, presence.PresenceStatus_OFFLINE)
+}
+
+// authContext 将连接绑定的 token 放入 gRPC 元数据，后端服务据此识别调用者
+func authContext(ctx context.Context, token string) context.Context {
+	md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+	return metadata.NewOutgoingContext(ctx, md)
+}
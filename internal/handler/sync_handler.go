@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"im-service/internal/protocol"
+	"im-service/internal/rpc/message"
+	"log"
+	"time"
+)
+
+// HandleSyncMessages 处理同步消息请求，将消息转换为与新消息推送相同的格式
+func HandleSyncMessages(ctx context.Context, client message.MessageServiceClient, req *message.SyncMessagesRequest) (*message.SyncMessagesResponse, []*protocol.NewMessageEvent, error) {
+	resp, err := client.SyncMessages(ctx, req)
+	if err != nil {
+		log.Printf("同步消息失败: %v", err)
+		return nil, nil, err
+	}
+
+	events := make([]*protocol.NewMessageEvent, 0, len(resp.Messages))
+	for _, item := range resp.Messages {
+		events = append(events, toNewMessageEvent(item))
+	}
+	return resp, events, nil
+}
+
+// toNewMessageEvent 将消息服务返回的消息项转换为与新消息推送相同的格式
+func toNewMessageEvent(item *message.MessageItem) *protocol.NewMessageEvent {
+	var timestamp int64
+	if t, err := time.Parse(time.RFC3339, item.Timestamp); err == nil {
+		timestamp = t.UnixMilli()
+	}
+	var editedAt int64
+	if t, err := time.Parse(time.RFC3339, item.EditedAt); err == nil {
+		editedAt = t.UnixMilli()
+	}
+	var expireAt int64
+	if t, err := time.Parse(time.RFC3339, item.ExpireAt); err == nil {
+		expireAt = t.UnixMilli()
+	}
+	return &protocol.NewMessageEvent{
+		From:              item.From,
+		To:                item.To,
+		Type:              protocol.MessageType(item.Type),
+		Content:           item.Content,
+		Attachments:       toProtocolAttachments(item.Attachments),
+		Location:          toProtocolLocation(item.Location),
+		Timestamp:         timestamp,
+		MessageId:         item.MessageId,
+		GroupId:           item.GroupId,
+		ConversationId:    item.ConversationId,
+		Seq:               item.Seq,
+		Recalled:          item.Recalled,
+		EditedAt:          editedAt,
+		ExpireAt:          expireAt,
+		EncryptedPayloads: toProtocolEncryptedPayloads(item.EncryptedPayloads),
+	}
+}
+
+// toProtocolAttachments 将消息服务返回的附件转换为协议中的附件
+func toProtocolAttachments(attachments []*message.Attachment) []*protocol.MessageAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	items := make([]*protocol.MessageAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		items = append(items, &protocol.MessageAttachment{
+			AttachmentId: a.AttachmentId,
+			FileName:     a.FileName,
+			MimeType:     a.MimeType,
+			Size:         a.Size,
+			Sha256:       a.Sha256,
+			Width:        a.Width,
+			Height:       a.Height,
+			DurationMs:   a.DurationMs,
+		})
+	}
+	return items
+}
+
+// toProtocolLocation 将消息服务返回的位置转换为协议中的位置
+func toProtocolLocation(location *message.Location) *protocol.MessageLocation {
+	if location == nil {
+		return nil
+	}
+	return &protocol.MessageLocation{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Name:      location.Name,
+		Address:   location.Address,
+	}
+}
+
+// toProtocolEncryptedPayloads 将消息服务返回的密文转换为协议中的密文
+func toProtocolEncryptedPayloads(payloads []*message.EncryptedPayload) []*protocol.EncryptedPayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+	items := make([]*protocol.EncryptedPayload, 0, len(payloads))
+	for _, p := range payloads {
+		items = append(items, &protocol.EncryptedPayload{
+			Recipient:  p.Recipient,
+			DeviceId:   p.DeviceId,
+			Type:       p.Type,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+	return items
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
@@ -10,8 +11,49 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"strings"
+	"time"
 )
 
+const (
+	// serviceTokenHeader 网关以服务身份调用后端时携带的内部凭证
+	serviceTokenHeader = "x-service-token"
+	// serviceUserHeader 服务身份调用所代表的用户
+	serviceUserHeader = "x-service-user"
+)
+
+// serviceToken 服务间调用的内部凭证，启动时由 SetServiceToken 设置，为空时不接受服务身份调用
+var serviceToken string
+
+// SetServiceToken 设置服务间调用的内部凭证，必须在启动 gRPC 服务和网关之前调用
+func SetServiceToken(token string) {
+	serviceToken = token
+}
+
+// WithServiceIdentity 以服务身份代表 username 调用后端，不依赖用户的 token 是否过期
+// 只用于网关自身发起的内部调用，例如连接断开时将设备标记为离线
+func WithServiceIdentity(ctx context.Context, username string) context.Context {
+	md := metadata.New(map[string]string{
+		serviceTokenHeader: serviceToken,
+		serviceUserHeader:  username,
+	})
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// serviceIdentity 校验元数据中的内部凭证，通过时返回所代表的用户
+func serviceIdentity(md metadata.MD) (string, bool) {
+	if serviceToken == "" {
+		return "", false
+	}
+	tokens, users := md.Get(serviceTokenHeader), md.Get(serviceUserHeader)
+	if len(tokens) == 0 || len(users) == 0 || users[0] == "" {
+		return "", false
+	}
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(serviceToken)) != 1 {
+		return "", false
+	}
+	return users[0], true
+}
+
 // AuthMiddleware 是一个中间件函数，用于验证 token 并将用户名添加到上下文中
 func AuthMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	// 从请求的元数据中获取 token
@@ -20,6 +62,11 @@ func AuthMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServer
 		return nil, status.Errorf(codes.Unauthenticated, "缺少元数据")
 	}
 
+	// 网关以服务身份发起的内部调用
+	if username, ok := serviceIdentity(md); ok {
+		return handler(context.WithValue(ctx, "username", username), req)
+	}
+
 	authHeader, ok := md["authorization"]
 	if !ok || len(authHeader) == 0 {
 		return nil, status.Errorf(codes.Unauthenticated, "缺少 Authorization 头")
@@ -31,7 +78,7 @@ func AuthMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServer
 	}
 
 	// 这里简单模拟 token 验证，实际中可使用 JWT 等库进行验证
-	username, err := validateToken(tokenString)
+	username, err := ValidateToken(tokenString)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "无效的 token: %v", err)
 	}
@@ -43,8 +90,28 @@ func AuthMiddleware(ctx context.Context, req interface{}, info *grpc.UnaryServer
 	return handler(newCtx, req)
 }
 
-// validateToken 使用 JWT 验证 token 并返回用户名
-func validateToken(tokenString string) (string, error) {
+// AuthMiddlewareExcept 返回跳过指定方法的认证拦截器，用于同一服务中注册、登录等无需认证的方法
+func AuthMiddlewareExcept(fullMethods ...string) grpc.UnaryServerInterceptor {
+	public := make(map[string]bool, len(fullMethods))
+	for _, method := range fullMethods {
+		public[method] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		return AuthMiddleware(ctx, req, info, handler)
+	}
+}
+
+// ValidateToken 使用 JWT 验证 token 并返回用户名，WebSocket 网关认证连接时同样使用
+func ValidateToken(tokenString string) (string, error) {
+	username, _, err := ParseToken(tokenString)
+	return username, err
+}
+
+// ParseToken 使用 JWT 验证 token，返回用户名和过期时间，token 没有过期时间时返回零值
+func ParseToken(tokenString string) (string, time.Time, error) {
 	// 解析 token
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// 验证签名方法，确保使用的是 HMAC 签名方法
@@ -56,7 +123,7 @@ func validateToken(tokenString string) (string, error) {
 	})
 
 	if err != nil {
-		return "", fmt.Errorf("解析 token 失败: %v", err)
+		return "", time.Time{}, fmt.Errorf("解析 token 失败: %v", err)
 	}
 
 	// 验证 token 的有效性
@@ -64,10 +131,14 @@ func validateToken(tokenString string) (string, error) {
 		// 获取用户名
 		username, ok := claims["username"].(string)
 		if !ok {
-			return "", errors.New("token 中缺少用户名")
+			return "", time.Time{}, errors.New("token 中缺少用户名")
+		}
+		var expiresAt time.Time
+		if exp, ok := claims["exp"].(float64); ok {
+			expiresAt = time.Unix(int64(exp), 0)
 		}
-		return username, nil
+		return username, expiresAt, nil
 	}
 
-	return "", errors.New("无效的 token")
+	return "", time.Time{}, errors.New("无效的 token")
 }
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"context"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// signToken 使用与登录相同的密钥签发 token
+func signToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("your_secret_key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestParseToken(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name     string
+		token    string
+		wantUser string
+		wantExp  time.Time
+		wantErr  bool
+	}{
+		{name: "带过期时间", token: signToken(t, jwt.MapClaims{"username": "alice", "exp": exp.Unix()}), wantUser: "alice", wantExp: exp},
+		{name: "没有过期时间", token: signToken(t, jwt.MapClaims{"username": "bob"}), wantUser: "bob"},
+		{name: "已过期", token: signToken(t, jwt.MapClaims{"username": "alice", "exp": time.Now().Add(-time.Minute).Unix()}), wantErr: true},
+		{name: "缺少用户名", token: signToken(t, jwt.MapClaims{"exp": exp.Unix()}), wantErr: true},
+		{name: "格式错误", token: "not-a-token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, gotExp, err := ParseToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if user != tt.wantUser || !gotExp.Equal(tt.wantExp) {
+				t.Errorf("ParseToken() = %q, %v, want %q, %v", user, gotExp, tt.wantUser, tt.wantExp)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	SetServiceToken("secret")
+	defer SetServiceToken("")
+	userToken := signToken(t, jwt.MapClaims{"username": "alice", "exp": time.Now().Add(time.Hour).Unix()})
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		wantUser string
+		wantCode codes.Code
+	}{
+		{name: "用户 token", md: metadata.Pairs("authorization", "Bearer "+userToken), wantUser: "alice"},
+		{name: "服务身份", md: metadata.Pairs(serviceTokenHeader, "secret", serviceUserHeader, "bob"), wantUser: "bob"},
+		{name: "服务凭证错误", md: metadata.Pairs(serviceTokenHeader, "wrong", serviceUserHeader, "bob"), wantCode: codes.Unauthenticated},
+		{name: "服务身份缺少用户", md: metadata.Pairs(serviceTokenHeader, "secret"), wantCode: codes.Unauthenticated},
+		{name: "没有凭证", md: metadata.MD{}, wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			var gotUser interface{}
+			_, err := AuthMiddleware(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotUser = ctx.Value("username")
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("AuthMiddleware() code = %v, want %v", code, tt.wantCode)
+			}
+			if tt.wantCode == codes.OK && gotUser != tt.wantUser {
+				t.Errorf("username = %v, want %q", gotUser, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestServiceIdentityDisabledWithoutToken(t *testing.T) {
+	SetServiceToken("")
+	md := metadata.Pairs(serviceTokenHeader, "", serviceUserHeader, "bob")
+	if _, ok := serviceIdentity(md); ok {
+		t.Error("serviceIdentity() accepted a call while no service token is configured")
+	}
+}
+
+func TestWithServiceIdentity(t *testing.T) {
+	SetServiceToken("secret")
+	defer SetServiceToken("")
+	md, _ := metadata.FromOutgoingContext(WithServiceIdentity(context.Background(), "carol"))
+	if user, ok := serviceIdentity(md); !ok || user != "carol" {
+		t.Errorf("serviceIdentity() = %q, %v, want carol, true", user, ok)
+	}
+}
@@ -0,0 +1,87 @@
+package protocol
+
+import (
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version 当前协议版本
+const Version = 1
+
+// WebSocket 子协议名称，客户端通过 Sec-WebSocket-Protocol 头选择编码方式
+const (
+	SubprotocolJSON     = "im.v1.json"
+	SubprotocolProtobuf = "im.v1.protobuf"
+)
+
+// Subprotocols 网关支持的子协议，按优先级排列
+var Subprotocols = []string{SubprotocolJSON, SubprotocolProtobuf}
+
+// Codec 定义帧的编解码方式
+type Codec interface {
+	// Name 返回对应的子协议名称
+	Name() string
+	// MessageType 返回写入 WebSocket 时使用的消息类型
+	MessageType() int
+	Marshal(frame *Frame) ([]byte, error)
+	Unmarshal(data []byte, frame *Frame) error
+}
+
+// CodecForSubprotocol 根据协商的子协议选择编解码器，未协商时默认使用 JSON
+func CodecForSubprotocol(subprotocol string) Codec {
+	if subprotocol == SubprotocolProtobuf {
+		return protobufCodec{}
+	}
+	return jsonCodec{}
+}
+
+// jsonCodec 使用 protojson 编码，字段名为 lowerCamelCase
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return SubprotocolJSON }
+
+func (jsonCodec) MessageType() int { return websocket.TextMessage }
+
+func (jsonCodec) Marshal(frame *Frame) ([]byte, error) {
+	return protojson.Marshal(frame)
+}
+
+func (jsonCodec) Unmarshal(data []byte, frame *Frame) error {
+	// 忽略未知字段，旧版本网关可以解析新版本客户端发来的帧
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, frame)
+}
+
+// protobufCodec 使用二进制 protobuf 编码
+type protobufCodec struct{}
+
+func (protobufCodec) Name() string { return SubprotocolProtobuf }
+
+func (protobufCodec) MessageType() int { return websocket.BinaryMessage }
+
+func (protobufCodec) Marshal(frame *Frame) ([]byte, error) {
+	return proto.Marshal(frame)
+}
+
+func (protobufCodec) Unmarshal(data []byte, frame *Frame) error {
+	return proto.Unmarshal(data, frame)
+}
+
+// NewPush 创建服务端主动推送的帧
+func NewPush() *Frame {
+	return &Frame{Version: Version}
+}
+
+// NewResponse 创建对请求帧的响应，沿用请求 ID
+func NewResponse(req *Frame) *Frame {
+	return &Frame{Version: Version, Id: req.GetId()}
+}
+
+// NewError 创建对请求帧的错误响应
+func NewError(req *Frame, code ErrorCode, message string) *Frame {
+	return &Frame{
+		Version: Version,
+		Id:      req.GetId(),
+		Error:   &Error{Code: code, Message: message},
+	}
+}
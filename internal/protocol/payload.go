@@ -0,0 +1,62 @@
+package protocol
+
+import (
+	"im-service/internal/data/model"
+	"strings"
+)
+
+// MessageTypeFromModel 将存储中的消息类型转换为协议中的枚举，缺少类型的旧消息视为文本
+func MessageTypeFromModel(messageType string) MessageType {
+	return MessageType(MessageType_value[strings.ToUpper(messageType)])
+}
+
+// AttachmentsFromModel 将存储中的附件转换为协议中的附件
+func AttachmentsFromModel(attachments []model.Attachment) []*MessageAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	items := make([]*MessageAttachment, 0, len(attachments))
+	for _, a := range attachments {
+		items = append(items, &MessageAttachment{
+			AttachmentId: a.AttachmentID,
+			FileName:     a.FileName,
+			MimeType:     a.MimeType,
+			Size:         a.Size,
+			Sha256:       a.SHA256,
+			Width:        a.Width,
+			Height:       a.Height,
+			DurationMs:   a.DurationMs,
+		})
+	}
+	return items
+}
+
+// LocationFromModel 将存储中的位置转换为协议中的位置
+func LocationFromModel(location *model.Location) *MessageLocation {
+	if location == nil {
+		return nil
+	}
+	return &MessageLocation{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Name:      location.Name,
+		Address:   location.Address,
+	}
+}
+
+// EncryptedPayloadsFromModel 将存储中的密文转换为协议中的密文
+func EncryptedPayloadsFromModel(payloads []model.EncryptedPayload) []*EncryptedPayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+	items := make([]*EncryptedPayload, 0, len(payloads))
+	for _, p := range payloads {
+		items = append(items, &EncryptedPayload{
+			Recipient:  p.Recipient,
+			DeviceId:   p.DeviceID,
+			Type:       p.Type,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+	return items
+}
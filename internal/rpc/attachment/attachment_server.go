@@ -0,0 +1,438 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/storage"
+	"im-service/internal/rpc/group"
+	"io"
+	"log"
+	"regexp"
+	"time"
+)
+
+// sha256Pattern 十六进制小写的 SHA-256
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// CustomAttachmentServiceServer 实现 AttachmentService 服务
+type CustomAttachmentServiceServer struct {
+	UnimplementedAttachmentServiceServer
+	mongoClient *mongodb.MongoClient
+	storage     storage.Storage
+}
+
+// NewCustomAttachmentServiceServer 创建附件服务端实例
+func NewCustomAttachmentServiceServer(mongoClient *mongodb.MongoClient, storage storage.Storage) *CustomAttachmentServiceServer {
+	return &CustomAttachmentServiceServer{
+		mongoClient: mongoClient,
+		storage:     storage,
+	}
+}
+
+// InitUpload 开始上传附件
+// 自己上传过相同内容时直接返回已完成的附件；其他用户上传过相同内容时仍需上传一遍以证明持有该内容，完成后共用存储对象
+func (s *CustomAttachmentServiceServer) InitUpload(ctx context.Context, req *InitUploadRequest) (*InitUploadResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &InitUploadResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.FileName == "" || req.MimeType == "" {
+		return &InitUploadResponse{
+			Success:  false,
+			ErrorMsg: "文件名和类型不能为空",
+		}, nil
+	}
+	if req.Size <= 0 || req.Size > maxAttachmentSize {
+		return &InitUploadResponse{
+			Success:  false,
+			ErrorMsg: "文件大小超出限制",
+		}, nil
+	}
+	if !sha256Pattern.MatchString(req.Sha256) {
+		return &InitUploadResponse{
+			Success:  false,
+			ErrorMsg: "无效的 SHA-256",
+		}, nil
+	}
+
+	// 自己已有相同内容的附件：已完成的直接复用，未完成的继续上传
+	collection := s.mongoClient.DB.Collection("attachments")
+	var existing attachmentDocument
+	err := collection.FindOne(ctx,
+		bson.M{"owner": req.Username, "sha256": req.Sha256, "size": req.Size},
+		options.FindOne().SetSort(bson.D{{Key: "status", Value: 1}}), // ready 排在 uploading 之前
+	).Decode(&existing)
+	if err == nil {
+		return &InitUploadResponse{
+			Success:        true,
+			AttachmentId:   existing.ID.Hex(),
+			Completed:      existing.Status == statusReady,
+			ChunkSize:      existing.ChunkSize,
+			ChunkCount:     existing.ChunkCount,
+			UploadedChunks: existing.UploadedChunks,
+		}, nil
+	}
+
+	doc := &attachmentDocument{
+		ID:             primitive.NewObjectID(),
+		Owner:          req.Username,
+		FileName:       req.FileName,
+		MimeType:       req.MimeType,
+		Size:           req.Size,
+		SHA256:         req.Sha256,
+		Width:          req.Width,
+		Height:         req.Height,
+		DurationMs:     req.DurationMs,
+		Status:         statusUploading,
+		ChunkSize:      chunkSize,
+		ChunkCount:     int32((req.Size + chunkSize - 1) / chunkSize),
+		UploadedChunks: []int32{},
+		StorageKey:     blobKey(req.Sha256),
+		CreatedAt:      time.Now(),
+	}
+	if _, err := collection.InsertOne(ctx, doc); err != nil {
+		return &InitUploadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	return &InitUploadResponse{
+		Success:        true,
+		AttachmentId:   doc.ID.Hex(),
+		ChunkSize:      doc.ChunkSize,
+		ChunkCount:     doc.ChunkCount,
+		UploadedChunks: doc.UploadedChunks,
+	}, nil
+}
+
+// UploadChunk 上传一个分片，重复上传同一分片会覆盖之前的内容
+func (s *CustomAttachmentServiceServer) UploadChunk(ctx context.Context, req *UploadChunkRequest) (*UploadChunkResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := findAttachment(ctx, s.mongoClient, req.AttachmentId)
+	if err != nil {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if doc == nil || doc.Owner != req.Username {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "附件不存在",
+		}, nil
+	}
+	if doc.Status != statusUploading {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "附件已上传完成",
+		}, nil
+	}
+	if req.Index < 0 || req.Index >= doc.ChunkCount {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "分片序号超出范围",
+		}, nil
+	}
+	if int64(len(req.Data)) != doc.chunkLength(req.Index) {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "分片大小不正确",
+		}, nil
+	}
+
+	err = s.storage.Put(ctx, chunkKey(req.AttachmentId, req.Index), bytes.NewReader(req.Data), int64(len(req.Data)), "application/octet-stream")
+	if err != nil {
+		log.Printf("保存附件 %s 的分片 %d 失败: %v", req.AttachmentId, req.Index, err)
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: "保存分片失败",
+		}, nil
+	}
+	_, err = s.mongoClient.DB.Collection("attachments").UpdateOne(ctx,
+		bson.M{"_id": doc.ID},
+		bson.M{"$addToSet": bson.M{"uploaded_chunks": req.Index}},
+	)
+	if err != nil {
+		return &UploadChunkResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	return &UploadChunkResponse{Success: true}, nil
+}
+
+// CompleteUpload 校验所有分片拼接后的 SHA-256，写入存储对象后附件可以被消息引用
+func (s *CustomAttachmentServiceServer) CompleteUpload(ctx context.Context, req *CompleteUploadRequest) (*CompleteUploadResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := findAttachment(ctx, s.mongoClient, req.AttachmentId)
+	if err != nil {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if doc == nil || doc.Owner != req.Username {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: "附件不存在",
+		}, nil
+	}
+	if doc.Status == statusReady {
+		return &CompleteUploadResponse{
+			Success:    true,
+			Attachment: doc.toAttachmentInfo(),
+		}, nil
+	}
+	if int32(len(doc.UploadedChunks)) != doc.ChunkCount {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: "还有分片未上传",
+		}, nil
+	}
+
+	// 先完整读一遍校验内容，校验通过后再写入共用的存储对象，避免错误的内容覆盖他人的文件
+	hash := sha256.New()
+	if err := s.copyChunks(ctx, doc, hash); err != nil {
+		log.Printf("读取附件 %s 的分片失败: %v", req.AttachmentId, err)
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: "读取分片失败",
+		}, nil
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != doc.SHA256 {
+		// 内容有误，清空已上传的分片，客户端需要重新上传
+		s.deleteChunks(doc)
+		_, _ = s.mongoClient.DB.Collection("attachments").UpdateOne(ctx,
+			bson.M{"_id": doc.ID},
+			bson.M{"$set": bson.M{"uploaded_chunks": []int32{}}},
+		)
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: "文件内容与 SHA-256 不一致，请重新上传",
+		}, nil
+	}
+
+	exists, err := s.storage.Exists(ctx, doc.StorageKey)
+	if err != nil {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if !exists {
+		if err := s.writeBlob(ctx, doc); err != nil {
+			log.Printf("写入附件 %s 失败: %v", req.AttachmentId, err)
+			return &CompleteUploadResponse{
+				Success:  false,
+				ErrorMsg: "写入附件失败",
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	_, err = s.mongoClient.DB.Collection("attachments").UpdateOne(ctx,
+		bson.M{"_id": doc.ID},
+		bson.M{"$set": bson.M{"status": statusReady, "completed_at": now}},
+	)
+	if err != nil {
+		return &CompleteUploadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	s.deleteChunks(doc)
+
+	return &CompleteUploadResponse{
+		Success:    true,
+		Attachment: doc.toAttachmentInfo(),
+	}, nil
+}
+
+// GetAttachment 返回附件信息和有时效的下载地址
+func (s *CustomAttachmentServiceServer) GetAttachment(ctx context.Context, req *GetAttachmentRequest) (*GetAttachmentResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := findAttachment(ctx, s.mongoClient, req.AttachmentId)
+	if err != nil {
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if doc == nil || doc.Status != statusReady {
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: "附件不存在",
+		}, nil
+	}
+	allowed, err := s.canAccess(ctx, doc, req.Username)
+	if err != nil {
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if !allowed {
+		// 不暴露附件是否存在
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: "附件不存在",
+		}, nil
+	}
+
+	expiresAt := time.Now().Add(urlExpiry)
+	url, err := s.storage.URL(ctx, doc.StorageKey, doc.FileName, urlExpiry)
+	if err != nil {
+		log.Printf("生成附件 %s 的下载地址失败: %v", req.AttachmentId, err)
+		return &GetAttachmentResponse{
+			Success:  false,
+			ErrorMsg: "生成下载地址失败",
+		}, nil
+	}
+	return &GetAttachmentResponse{
+		Success:      true,
+		Attachment:   doc.toAttachmentInfo(),
+		Url:          url,
+		UrlExpiresAt: expiresAt.UnixMilli(),
+	}, nil
+}
+
+// canAccess 上传者，以及单聊中收到引用该附件的消息的用户、引用该附件的群消息所在群的成员可以访问附件
+func (s *CustomAttachmentServiceServer) canAccess(ctx context.Context, doc *attachmentDocument, username string) (bool, error) {
+	if doc.Owner == username {
+		return true, nil
+	}
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	attachmentID := doc.ID.Hex()
+	count, err := messagesCollection.CountDocuments(ctx, bson.M{
+		"attachments.attachment_id": attachmentID,
+		"to":                        username,
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return true, nil
+	}
+
+	groupIDs, err := messagesCollection.Distinct(ctx, "group_id", bson.M{
+		"attachments.attachment_id": attachmentID,
+		"group_id":                  bson.M{"$exists": true},
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, value := range groupIDs {
+		groupID, ok := value.(string)
+		if !ok {
+			continue
+		}
+		role, err := group.GetMemberRole(ctx, s.mongoClient, groupID, username)
+		if err != nil {
+			return false, err
+		}
+		if role != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// chunkLength 返回分片应有的字节数，最后一片可能不足 ChunkSize
+func (d *attachmentDocument) chunkLength(index int32) int64 {
+	if index < d.ChunkCount-1 {
+		return int64(d.ChunkSize)
+	}
+	return d.Size - int64(d.ChunkSize)*int64(d.ChunkCount-1)
+}
+
+// copyChunks 按顺序将所有分片写入 w
+func (s *CustomAttachmentServiceServer) copyChunks(ctx context.Context, doc *attachmentDocument, w io.Writer) error {
+	attachmentID := doc.ID.Hex()
+	for index := int32(0); index < doc.ChunkCount; index++ {
+		chunk, err := s.storage.Get(ctx, chunkKey(attachmentID, index))
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBlob 将分片拼接后写入附件的存储对象，写入的内容再校验一次，防止校验之后分片被覆盖
+// 先写入哈希再写入管道，Put 读完最后一个字节时哈希已包含全部内容
+func (s *CustomAttachmentServiceServer) writeBlob(ctx context.Context, doc *attachmentDocument) error {
+	reader, writer := io.Pipe()
+	hash := sha256.New()
+	go func() {
+		writer.CloseWithError(s.copyChunks(ctx, doc, io.MultiWriter(hash, writer)))
+	}()
+	err := s.storage.Put(ctx, doc.StorageKey, reader, doc.Size, doc.MimeType)
+	// Put 提前返回时让写协程退出
+	reader.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != doc.SHA256 {
+		if err := s.storage.Delete(ctx, doc.StorageKey); err != nil {
+			log.Printf("删除内容有误的附件 %s 失败: %v", doc.ID.Hex(), err)
+		}
+		return errors.New("写入的内容与 SHA-256 不一致")
+	}
+	return nil
+}
+
+// deleteChunks 删除附件的所有分片，失败只记录日志
+func (s *CustomAttachmentServiceServer) deleteChunks(doc *attachmentDocument) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	attachmentID := doc.ID.Hex()
+	for index := int32(0); index < doc.ChunkCount; index++ {
+		if err := s.storage.Delete(ctx, chunkKey(attachmentID, index)); err != nil {
+			log.Printf("删除附件 %s 的分片 %d 失败: %v", attachmentID, index, err)
+		}
+	}
+}
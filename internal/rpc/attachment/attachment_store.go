@@ -0,0 +1,138 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"im-service/internal/data/mongodb"
+	"strconv"
+	"time"
+)
+
+// 附件的上传状态
+const (
+	statusUploading = "uploading"
+	statusReady     = "ready"
+)
+
+const (
+	// chunkSize 分片大小
+	chunkSize = 512 * 1024
+	// maxAttachmentSize 单个附件的大小上限
+	maxAttachmentSize = 100 * 1024 * 1024
+	// urlExpiry 下载地址的有效期
+	urlExpiry = time.Hour
+)
+
+// ErrInvalidAttachment 附件不存在、尚未上传完成或不属于发送者
+var ErrInvalidAttachment = errors.New("附件不存在或不属于你")
+
+// attachmentDocument attachments 集合中的附件文档
+// 内容相同的附件共用一个存储对象，每个上传者各有一条附件记录
+type attachmentDocument struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	Owner          string             `bson:"owner"`
+	FileName       string             `bson:"file_name"`
+	MimeType       string             `bson:"mime_type"`
+	Size           int64              `bson:"size"`
+	SHA256         string             `bson:"sha256"`
+	Width          int32              `bson:"width,omitempty"`
+	Height         int32              `bson:"height,omitempty"`
+	DurationMs     int32              `bson:"duration_ms,omitempty"`
+	Status         string             `bson:"status"`
+	ChunkSize      int32              `bson:"chunk_size"`
+	ChunkCount     int32              `bson:"chunk_count"`
+	UploadedChunks []int32            `bson:"uploaded_chunks"`
+	StorageKey     string             `bson:"storage_key"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	CompletedAt    *time.Time         `bson:"completed_at,omitempty"`
+}
+
+// toAttachmentInfo 转换为接口中的附件信息
+func (d *attachmentDocument) toAttachmentInfo() *AttachmentInfo {
+	return &AttachmentInfo{
+		AttachmentId: d.ID.Hex(),
+		FileName:     d.FileName,
+		MimeType:     d.MimeType,
+		Size:         d.Size,
+		Sha256:       d.SHA256,
+		Width:        d.Width,
+		Height:       d.Height,
+		DurationMs:   d.DurationMs,
+	}
+}
+
+// blobKey 返回内容对应的存储对象 key
+func blobKey(sha256 string) string {
+	return "blobs/" + sha256[:2] + "/" + sha256
+}
+
+// chunkKey 返回上传中的分片的存储对象 key
+func chunkKey(attachmentID string, index int32) string {
+	return "uploads/" + attachmentID + "/" + strconv.Itoa(int(index))
+}
+
+// findAttachment 查询附件，不存在时返回 nil
+func findAttachment(ctx context.Context, mongoClient *mongodb.MongoClient, attachmentID string) (*attachmentDocument, error) {
+	id, err := primitive.ObjectIDFromHex(attachmentID)
+	if err != nil {
+		return nil, nil
+	}
+	var doc attachmentDocument
+	err = mongoClient.DB.Collection("attachments").FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// LookupOwnedAttachments 按顺序返回 owner 已上传完成的附件，任意一个不满足时返回 ErrInvalidAttachment
+func LookupOwnedAttachments(ctx context.Context, mongoClient *mongodb.MongoClient, owner string, attachmentIDs []string) ([]*AttachmentInfo, error) {
+	ids := make([]primitive.ObjectID, 0, len(attachmentIDs))
+	for _, attachmentID := range attachmentIDs {
+		id, err := primitive.ObjectIDFromHex(attachmentID)
+		if err != nil {
+			return nil, ErrInvalidAttachment
+		}
+		ids = append(ids, id)
+	}
+	cursor, err := mongoClient.DB.Collection("attachments").Find(ctx, bson.M{
+		"_id":    bson.M{"$in": ids},
+		"owner":  owner,
+		"status": statusReady,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var docs []attachmentDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	found := make(map[primitive.ObjectID]*attachmentDocument, len(docs))
+	for i := range docs {
+		found[docs[i].ID] = &docs[i]
+	}
+
+	infos := make([]*AttachmentInfo, 0, len(ids))
+	for _, id := range ids {
+		doc, ok := found[id]
+		if !ok {
+			return nil, ErrInvalidAttachment
+		}
+		infos = append(infos, doc.toAttachmentInfo())
+	}
+	return infos, nil
+}
+
+// EnsureIndexes 创建附件集合的索引
+func EnsureIndexes(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	_, err := mongoClient.DB.Collection("attachments").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "sha256", Value: 1}, {Key: "size", Value: 1}}},
+	})
+	return err
+}
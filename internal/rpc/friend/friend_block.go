@@ -0,0 +1,205 @@
+package friend
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"log"
+	"time"
+)
+
+var (
+	// ErrUnavailable 被对方拉黑时返回的通用错误，不向被拉黑的用户透露拉黑状态
+	ErrUnavailable = errors.New("操作失败，请稍后再试")
+	// ErrBlockedByYou 调用者自己拉黑了对方
+	ErrBlockedByYou = errors.New("你已将对方加入黑名单，解除后才能继续")
+)
+
+// blockDocument blocks 集合中的文档，owner 拉黑了 target
+type blockDocument struct {
+	Owner     string    `bson:"owner"`
+	Target    string    `bson:"target"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// CheckBlocked 检查 from 是否可以联系 to：from 拉黑了 to 时返回 ErrBlockedByYou，被 to 拉黑时返回 ErrUnavailable
+func CheckBlocked(ctx context.Context, mongoClient *mongodb.MongoClient, from, to string) error {
+	cursor, err := mongoClient.DB.Collection("blocks").Find(ctx, bson.M{
+		"$or": []bson.M{
+			{"owner": from, "target": to},
+			{"owner": to, "target": from},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	var blocks []blockDocument
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		if block.Owner == from {
+			return ErrBlockedByYou
+		}
+	}
+	if len(blocks) > 0 {
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// BlockUser 拉黑用户，同时解除双方的好友关系；对方待处理的好友请求视为被拒绝，自己发出的待处理请求撤回，均不通知对方
+func (s *CustomFriendServiceServer) BlockUser(ctx context.Context, req *BlockUserRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.Target == "" || req.Target == req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "无效的用户",
+		}, nil
+	}
+
+	// 拉黑、处理待处理请求和解除好友关系在同一事务中完成，避免留下拉黑后仍是好友的中间状态
+	wereFriends := false
+	err := withTransaction(ctx, s.mongoClient, func(sessCtx mongo.SessionContext) error {
+		// 事务可能重试，每次都重新判断
+		wereFriends = false
+		now := time.Now()
+		_, err := s.mongoClient.DB.Collection("blocks").UpdateOne(sessCtx,
+			bson.M{"owner": req.Username, "target": req.Target},
+			bson.M{"$setOnInsert": bson.M{"created_at": now}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return err
+		}
+
+		friendRequestsCollection := s.mongoClient.DB.Collection("friend_requests")
+		_, err = friendRequestsCollection.UpdateMany(sessCtx,
+			bson.M{"from": req.Target, "to": req.Username, "status": requestPending},
+			bson.M{"$set": bson.M{"status": requestRejected, "timestamp": now, "responded_at": now}},
+		)
+		if err != nil {
+			return err
+		}
+		_, err = friendRequestsCollection.UpdateMany(sessCtx,
+			bson.M{"from": req.Username, "to": req.Target, "status": requestPending},
+			bson.M{"$set": bson.M{"status": requestCancelled, "timestamp": now}},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = endFriendship(sessCtx, s.mongoClient, req.Username, req.Target)
+		if errors.Is(err, errNotFriends) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		wereFriends = true
+		return nil
+	})
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	if wereFriends {
+		invalidateFriends(ctx, s.redisClient, req.Username, req.Target)
+		if err := s.kafkaProducer.SendFriendRemoved(req.Username, req.Target); err != nil {
+			log.Printf("发送删除好友事件到 Kafka 失败: %v", err)
+		}
+	}
+	if err := s.kafkaProducer.SendBlockChanged(req.Username, req.Target, true); err != nil {
+		log.Printf("发送黑名单变化事件到 Kafka 失败: %v", err)
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
+
+// UnblockUser 解除拉黑，之前被拒绝或撤回的好友请求不会恢复
+func (s *CustomFriendServiceServer) UnblockUser(ctx context.Context, req *BlockUserRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	result, err := s.mongoClient.DB.Collection("blocks").DeleteOne(ctx, bson.M{"owner": req.Username, "target": req.Target})
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if result.DeletedCount == 0 {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "对方不在黑名单中",
+		}, nil
+	}
+
+	if err := s.kafkaProducer.SendBlockChanged(req.Username, req.Target, false); err != nil {
+		log.Printf("发送黑名单变化事件到 Kafka 失败: %v", err)
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
+
+// ListBlockedUsers 查询自己的黑名单
+func (s *CustomFriendServiceServer) ListBlockedUsers(ctx context.Context, req *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &ListBlockedUsersResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	cursor, err := s.mongoClient.DB.Collection("blocks").Find(ctx,
+		bson.M{"owner": req.Username},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return &ListBlockedUsersResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var blocks []blockDocument
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return &ListBlockedUsersResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	blockedUsers := make([]*BlockedUser, 0, len(blocks))
+	for _, block := range blocks {
+		blockedUsers = append(blockedUsers, &BlockedUser{
+			Username:  block.Target,
+			BlockedAt: block.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return &ListBlockedUsersResponse{
+		BlockedUsers: blockedUsers,
+		Success:      true,
+	}, nil
+}
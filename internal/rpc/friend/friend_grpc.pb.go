@@ -19,9 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FriendService_SendFriendRequest_FullMethodName   = "/FriendService/SendFriendRequest"
-	FriendService_AcceptFriendRequest_FullMethodName = "/FriendService/AcceptFriendRequest"
-	FriendService_GetFriendList_FullMethodName       = "/FriendService/GetFriendList"
+	FriendService_SendFriendRequest_FullMethodName          = "/FriendService/SendFriendRequest"
+	FriendService_AcceptFriendRequest_FullMethodName        = "/FriendService/AcceptFriendRequest"
+	FriendService_RejectFriendRequest_FullMethodName        = "/FriendService/RejectFriendRequest"
+	FriendService_CancelFriendRequest_FullMethodName        = "/FriendService/CancelFriendRequest"
+	FriendService_ListIncomingFriendRequests_FullMethodName = "/FriendService/ListIncomingFriendRequests"
+	FriendService_ListOutgoingFriendRequests_FullMethodName = "/FriendService/ListOutgoingFriendRequests"
+	FriendService_GetFriendList_FullMethodName              = "/FriendService/GetFriendList"
+	FriendService_DeleteFriend_FullMethodName               = "/FriendService/DeleteFriend"
+	FriendService_BlockUser_FullMethodName                  = "/FriendService/BlockUser"
+	FriendService_UnblockUser_FullMethodName                = "/FriendService/UnblockUser"
+	FriendService_ListBlockedUsers_FullMethodName           = "/FriendService/ListBlockedUsers"
+	FriendService_UpdateFriend_FullMethodName               = "/FriendService/UpdateFriend"
+	FriendService_GetFriend_FullMethodName                  = "/FriendService/GetFriend"
+	FriendService_ListFriendTags_FullMethodName             = "/FriendService/ListFriendTags"
+	FriendService_RenameFriendTag_FullMethodName            = "/FriendService/RenameFriendTag"
+	FriendService_DeleteFriendTag_FullMethodName            = "/FriendService/DeleteFriendTag"
+	FriendService_SuggestFriends_FullMethodName             = "/FriendService/SuggestFriends"
 )
 
 // FriendServiceClient is the client API for FriendService service.
@@ -34,8 +48,34 @@ type FriendServiceClient interface {
 	SendFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
 	// 同意好友请求
 	AcceptFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
+	// 拒绝好友请求，调用者为接收者
+	RejectFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
+	// 撤回好友请求，调用者为发送者
+	CancelFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error)
+	// 查询发给自己的好友请求
+	ListIncomingFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error)
+	// 查询自己发出的好友请求
+	ListOutgoingFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error)
 	// 获取好友列表
 	GetFriendList(ctx context.Context, in *GetFriendListRequest, opts ...grpc.CallOption) (*GetFriendListResponse, error)
+	// 删除好友
+	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	// 拉黑用户并解除双方的好友关系，双方不能再互发消息和好友请求
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	// 解除拉黑
+	UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	// 查询黑名单
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	// 修改好友的备注、标签和星标
+	UpdateFriend(ctx context.Context, in *UpdateFriendRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	// 查询单个好友
+	GetFriend(ctx context.Context, in *GetFriendRequest, opts ...grpc.CallOption) (*GetFriendResponse, error)
+	// 查询、重命名和删除标签
+	ListFriendTags(ctx context.Context, in *ListFriendTagsRequest, opts ...grpc.CallOption) (*ListFriendTagsResponse, error)
+	RenameFriendTag(ctx context.Context, in *RenameFriendTagRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	DeleteFriendTag(ctx context.Context, in *DeleteFriendTagRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error)
+	// 可能认识的人
+	SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error)
 }
 
 type friendServiceClient struct {
@@ -66,6 +106,46 @@ func (c *friendServiceClient) AcceptFriendRequest(ctx context.Context, in *Frien
 	return out, nil
 }
 
+func (c *friendServiceClient) RejectFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendRequestResponse)
+	err := c.cc.Invoke(ctx, FriendService_RejectFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) CancelFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*FriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendRequestResponse)
+	err := c.cc.Invoke(ctx, FriendService_CancelFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) ListIncomingFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, FriendService_ListIncomingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) ListOutgoingFriendRequests(ctx context.Context, in *ListFriendRequestsRequest, opts ...grpc.CallOption) (*ListFriendRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, FriendService_ListOutgoingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *friendServiceClient) GetFriendList(ctx context.Context, in *GetFriendListRequest, opts ...grpc.CallOption) (*GetFriendListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFriendListResponse)
@@ -76,6 +156,106 @@ func (c *friendServiceClient) GetFriendList(ctx context.Context, in *GetFriendLi
 	return out, nil
 }
 
+func (c *friendServiceClient) DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_DeleteFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, FriendService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) UpdateFriend(ctx context.Context, in *UpdateFriendRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_UpdateFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) GetFriend(ctx context.Context, in *GetFriendRequest, opts ...grpc.CallOption) (*GetFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendResponse)
+	err := c.cc.Invoke(ctx, FriendService_GetFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) ListFriendTags(ctx context.Context, in *ListFriendTagsRequest, opts ...grpc.CallOption) (*ListFriendTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendTagsResponse)
+	err := c.cc.Invoke(ctx, FriendService_ListFriendTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) RenameFriendTag(ctx context.Context, in *RenameFriendTagRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_RenameFriendTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) DeleteFriendTag(ctx context.Context, in *DeleteFriendTagRequest, opts ...grpc.CallOption) (*FriendOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FriendOperationResponse)
+	err := c.cc.Invoke(ctx, FriendService_DeleteFriendTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendServiceClient) SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestFriendsResponse)
+	err := c.cc.Invoke(ctx, FriendService_SuggestFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServiceServer is the server API for FriendService service.
 // All implementations must embed UnimplementedFriendServiceServer
 // for forward compatibility.
@@ -86,8 +266,34 @@ type FriendServiceServer interface {
 	SendFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error)
 	// 同意好友请求
 	AcceptFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error)
+	// 拒绝好友请求，调用者为接收者
+	RejectFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error)
+	// 撤回好友请求，调用者为发送者
+	CancelFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error)
+	// 查询发给自己的好友请求
+	ListIncomingFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error)
+	// 查询自己发出的好友请求
+	ListOutgoingFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error)
 	// 获取好友列表
 	GetFriendList(context.Context, *GetFriendListRequest) (*GetFriendListResponse, error)
+	// 删除好友
+	DeleteFriend(context.Context, *DeleteFriendRequest) (*FriendOperationResponse, error)
+	// 拉黑用户并解除双方的好友关系，双方不能再互发消息和好友请求
+	BlockUser(context.Context, *BlockUserRequest) (*FriendOperationResponse, error)
+	// 解除拉黑
+	UnblockUser(context.Context, *BlockUserRequest) (*FriendOperationResponse, error)
+	// 查询黑名单
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	// 修改好友的备注、标签和星标
+	UpdateFriend(context.Context, *UpdateFriendRequest) (*FriendOperationResponse, error)
+	// 查询单个好友
+	GetFriend(context.Context, *GetFriendRequest) (*GetFriendResponse, error)
+	// 查询、重命名和删除标签
+	ListFriendTags(context.Context, *ListFriendTagsRequest) (*ListFriendTagsResponse, error)
+	RenameFriendTag(context.Context, *RenameFriendTagRequest) (*FriendOperationResponse, error)
+	DeleteFriendTag(context.Context, *DeleteFriendTagRequest) (*FriendOperationResponse, error)
+	// 可能认识的人
+	SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error)
 	mustEmbedUnimplementedFriendServiceServer()
 }
 
@@ -104,9 +310,51 @@ func (UnimplementedFriendServiceServer) SendFriendRequest(context.Context, *Frie
 func (UnimplementedFriendServiceServer) AcceptFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
 }
+func (UnimplementedFriendServiceServer) RejectFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFriendRequest not implemented")
+}
+func (UnimplementedFriendServiceServer) CancelFriendRequest(context.Context, *FriendRequest) (*FriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedFriendServiceServer) ListIncomingFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncomingFriendRequests not implemented")
+}
+func (UnimplementedFriendServiceServer) ListOutgoingFriendRequests(context.Context, *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingFriendRequests not implemented")
+}
 func (UnimplementedFriendServiceServer) GetFriendList(context.Context, *GetFriendListRequest) (*GetFriendListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFriendList not implemented")
 }
+func (UnimplementedFriendServiceServer) DeleteFriend(context.Context, *DeleteFriendRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriend not implemented")
+}
+func (UnimplementedFriendServiceServer) BlockUser(context.Context, *BlockUserRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedFriendServiceServer) UnblockUser(context.Context, *BlockUserRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedFriendServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedFriendServiceServer) UpdateFriend(context.Context, *UpdateFriendRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFriend not implemented")
+}
+func (UnimplementedFriendServiceServer) GetFriend(context.Context, *GetFriendRequest) (*GetFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriend not implemented")
+}
+func (UnimplementedFriendServiceServer) ListFriendTags(context.Context, *ListFriendTagsRequest) (*ListFriendTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriendTags not implemented")
+}
+func (UnimplementedFriendServiceServer) RenameFriendTag(context.Context, *RenameFriendTagRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFriendTag not implemented")
+}
+func (UnimplementedFriendServiceServer) DeleteFriendTag(context.Context, *DeleteFriendTagRequest) (*FriendOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriendTag not implemented")
+}
+func (UnimplementedFriendServiceServer) SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFriends not implemented")
+}
 func (UnimplementedFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {}
 func (UnimplementedFriendServiceServer) testEmbeddedByValue()                       {}
 
@@ -164,6 +412,78 @@ func _FriendService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_RejectFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).RejectFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_RejectFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).RejectFriendRequest(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_CancelFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).CancelFriendRequest(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_ListIncomingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).ListIncomingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_ListIncomingFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).ListIncomingFriendRequests(ctx, req.(*ListFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_ListOutgoingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).ListOutgoingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_ListOutgoingFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).ListOutgoingFriendRequests(ctx, req.(*ListFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FriendService_GetFriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFriendListRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +502,186 @@ func _FriendService_GetFriendList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FriendService_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).DeleteFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_DeleteFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).DeleteFriend(ctx, req.(*DeleteFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).UnblockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_UpdateFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).UpdateFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_UpdateFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).UpdateFriend(ctx, req.(*UpdateFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_GetFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).GetFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_GetFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).GetFriend(ctx, req.(*GetFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_ListFriendTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).ListFriendTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_ListFriendTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).ListFriendTags(ctx, req.(*ListFriendTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_RenameFriendTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFriendTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).RenameFriendTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_RenameFriendTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).RenameFriendTag(ctx, req.(*RenameFriendTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_DeleteFriendTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).DeleteFriendTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_DeleteFriendTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).DeleteFriendTag(ctx, req.(*DeleteFriendTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FriendService_SuggestFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServiceServer).SuggestFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FriendService_SuggestFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServiceServer).SuggestFriends(ctx, req.(*SuggestFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FriendService_ServiceDesc is the grpc.ServiceDesc for FriendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,10 +697,66 @@ var FriendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptFriendRequest",
 			Handler:    _FriendService_AcceptFriendRequest_Handler,
 		},
+		{
+			MethodName: "RejectFriendRequest",
+			Handler:    _FriendService_RejectFriendRequest_Handler,
+		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _FriendService_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "ListIncomingFriendRequests",
+			Handler:    _FriendService_ListIncomingFriendRequests_Handler,
+		},
+		{
+			MethodName: "ListOutgoingFriendRequests",
+			Handler:    _FriendService_ListOutgoingFriendRequests_Handler,
+		},
 		{
 			MethodName: "GetFriendList",
 			Handler:    _FriendService_GetFriendList_Handler,
 		},
+		{
+			MethodName: "DeleteFriend",
+			Handler:    _FriendService_DeleteFriend_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _FriendService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _FriendService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _FriendService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "UpdateFriend",
+			Handler:    _FriendService_UpdateFriend_Handler,
+		},
+		{
+			MethodName: "GetFriend",
+			Handler:    _FriendService_GetFriend_Handler,
+		},
+		{
+			MethodName: "ListFriendTags",
+			Handler:    _FriendService_ListFriendTags_Handler,
+		},
+		{
+			MethodName: "RenameFriendTag",
+			Handler:    _FriendService_RenameFriendTag_Handler,
+		},
+		{
+			MethodName: "DeleteFriendTag",
+			Handler:    _FriendService_DeleteFriendTag_Handler,
+		},
+		{
+			MethodName: "SuggestFriends",
+			Handler:    _FriendService_SuggestFriends_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/rpc/friend/friend.proto",
@@ -0,0 +1,148 @@
+package friend
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+)
+
+// ReconcileResult 修复好友关系的统计结果
+type ReconcileResult struct {
+	Friendships int // 修复后的好友关系数
+	Created     int // 根据已接受的好友请求补建的好友关系
+	Updated     int // 补齐 pair 字段或调整用户顺序的好友关系
+	Removed     int // 删除的重复或已解除的好友关系文档
+}
+
+// legacyFriendship friends 集合中的旧文档，可能缺少 pair 字段、用户顺序不固定或同一对用户有多条
+type legacyFriendship struct {
+	ID    primitive.ObjectID `bson:"_id"`
+	Pair  string             `bson:"pair,omitempty"`
+	User1 string             `bson:"user1"`
+	User2 string             `bson:"user2"`
+}
+
+// ReconcileFriendships 以 friend_requests 和 friends 两个集合为依据修复好友关系，使 friends 成为唯一的好友关系来源，可重复执行
+// 每对用户最近一次被接受的好友请求决定是否为好友（标记了 removed_at 表示已删除）；没有已接受请求的，保留 friends 中已有的关系
+// dryRun 为 true 时只统计不写入；写入后删除受影响用户的好友缓存
+func ReconcileFriendships(ctx context.Context, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, dryRun bool) (*ReconcileResult, error) {
+	// 按 _id 正序遍历，同一对用户后面的请求覆盖前面的
+	latest := map[string]*friendRequestDocument{}
+	cursor, err := mongoClient.DB.Collection("friend_requests").Find(ctx,
+		bson.M{"status": requestAccepted},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var requests []friendRequestDocument
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	for i := range requests {
+		latest[pairKey(requests[i].From, requests[i].To)] = &requests[i]
+	}
+
+	friendsCollection := mongoClient.DB.Collection("friends")
+	cursor, err = friendsCollection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var docs []legacyFriendship
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	existing := map[string][]legacyFriendship{}
+	for _, doc := range docs {
+		pair := pairKey(doc.User1, doc.User2)
+		existing[pair] = append(existing[pair], doc)
+	}
+
+	// 每对用户按字典序排列的用户名
+	pairs := map[string][2]string{}
+	for pair, request := range latest {
+		pairs[pair] = sortedPair(request.From, request.To)
+	}
+	for pair, docs := range existing {
+		pairs[pair] = sortedPair(docs[0].User1, docs[0].User2)
+	}
+
+	result := &ReconcileResult{}
+	affected := map[string]bool{}
+	for pair, users := range pairs {
+		request := latest[pair]
+		stale := existing[pair]
+		isFriend := len(stale) > 0
+		if request != nil {
+			isFriend = request.RemovedAt == nil
+		}
+
+		// 保留最早的一条，其余的作为重复文档删除；已解除的关系全部删除
+		var keep *legacyFriendship
+		if isFriend && len(stale) > 0 {
+			keep = &stale[0]
+			stale = stale[1:]
+		}
+		changed := len(stale) > 0
+		if len(stale) > 0 {
+			result.Removed += len(stale)
+			if !dryRun {
+				ids := make([]primitive.ObjectID, 0, len(stale))
+				for _, doc := range stale {
+					ids = append(ids, doc.ID)
+				}
+				if _, err := friendsCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		switch {
+		case !isFriend:
+		case keep == nil:
+			result.Created++
+			changed = true
+			if !dryRun {
+				timestamp := request.Timestamp
+				if request.RespondedAt != nil {
+					timestamp = *request.RespondedAt
+				}
+				if _, err := friendsCollection.InsertOne(ctx, newFriendship(request.From, request.To, request.ID, timestamp)); err != nil {
+					return nil, err
+				}
+			}
+		case keep.Pair != pair || keep.User1 != users[0]:
+			result.Updated++
+			changed = true
+			if !dryRun {
+				_, err := friendsCollection.UpdateOne(ctx,
+					bson.M{"_id": keep.ID},
+					bson.M{"$set": bson.M{"pair": pair, "user1": users[0], "user2": users[1]}},
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if isFriend {
+			result.Friendships++
+		}
+		if changed {
+			affected[users[0]] = true
+			affected[users[1]] = true
+		}
+	}
+
+	if !dryRun && len(affected) > 0 {
+		usernames := make([]string, 0, len(affected))
+		for username := range affected {
+			usernames = append(usernames, username)
+		}
+		invalidateFriends(ctx, redisClient, usernames...)
+	}
+	return result, nil
+}
@@ -0,0 +1,332 @@
+package friend
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultRejectCooldown 未配置时被拒绝后再次发送好友请求需要等待的时间
+	defaultRejectCooldown = 24 * time.Hour
+	// defaultRequestLimit 查询好友请求的默认条数
+	defaultRequestLimit = 20
+	// maxRequestLimit 查询好友请求的最大条数
+	maxRequestLimit = 100
+)
+
+// 好友请求在 friend_requests 集合中的状态
+const (
+	requestPending   = "pending"
+	requestAccepted  = "accepted"
+	requestRejected  = "rejected"
+	requestCancelled = "cancelled"
+)
+
+var (
+	errRequestNotFound = errors.New("好友请求不存在或已处理")
+	errInvalidCursor   = errors.New("无效的游标")
+	errPendingRequest  = errors.New("双方之间已有待处理的好友请求")
+)
+
+// friendRequestDocument friend_requests 集合中的文档，timestamp 为最后一次状态变化的时间
+type friendRequestDocument struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	From        string             `bson:"from"`
+	To          string             `bson:"to"`
+	Pair        string             `bson:"pair,omitempty"` // 排序后的两个用户，双方之间只能有一个待处理的请求
+	Status      string             `bson:"status"`
+	Timestamp   time.Time          `bson:"timestamp"`
+	RespondedAt *time.Time         `bson:"responded_at,omitempty"` // 接收者同意或拒绝的时间
+	RemovedAt   *time.Time         `bson:"removed_at,omitempty"`   // 已接受的请求对应的好友关系被删除的时间
+}
+
+// toFriendRequestItem 转换为接口中的好友请求，创建时间取自 ObjectID
+func (d *friendRequestDocument) toFriendRequestItem() *FriendRequestItem {
+	return &FriendRequestItem{
+		RequestId: d.ID.Hex(),
+		From:      d.From,
+		To:        d.To,
+		Status:    FriendRequestStatus(FriendRequestStatus_value[strings.ToUpper(d.Status)]),
+		CreatedAt: d.ID.Timestamp().Format(time.RFC3339),
+		UpdatedAt: d.Timestamp.Format(time.RFC3339),
+	}
+}
+
+// EnsureIndexes 创建好友关系、好友设置、好友请求和黑名单集合的索引
+func EnsureIndexes(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	_, err := mongoClient.DB.Collection("friends").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// 每对用户只有一条好友关系；迁移前的旧文档没有 pair 字段，不参与唯一约束
+		{
+			Keys:    bson.D{{Key: "pair", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"pair": bson.M{"$exists": true}}),
+		},
+		{Keys: bson.D{{Key: "user1", Value: 1}}},
+		{Keys: bson.D{{Key: "user2", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("friend_settings").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "target", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "tags", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	if err := migratePendingRequests(ctx, mongoClient); err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("friend_requests").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "to", Value: 1}, {Key: "status", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "from", Value: 1}, {Key: "status", Value: 1}, {Key: "_id", Value: -1}}},
+		// 双方之间只能有一个待处理的请求，并发发送时只有一个能写入
+		{
+			Keys:    bson.D{{Key: "pair", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": requestPending}),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("blocks").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "target", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		// 检查是否被对方拉黑
+		{Keys: bson.D{{Key: "target", Value: 1}}},
+	})
+	return err
+}
+
+// migratePendingRequests 为旧的好友请求补齐 pair 字段，同一对用户有多个待处理的请求时只保留最新的一个，其余标记为撤回，
+// 之后才能创建待处理请求的唯一索引
+func migratePendingRequests(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	collection := mongoClient.DB.Collection("friend_requests")
+	_, err := collection.UpdateMany(ctx,
+		bson.M{"pair": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"pair": bson.M{"$cond": bson.A{
+				bson.M{"$lt": bson.A{"$from", "$to"}},
+				bson.M{"$concat": bson.A{"$from", ":", "$to"}},
+				bson.M{"$concat": bson.A{"$to", ":", "$from"}},
+			}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": requestPending}}},
+		{{Key: "$group", Value: bson.M{"_id": "$pair", "ids": bson.M{"$push": "$_id"}, "latest": bson.M{"$max": "$_id"}, "count": bson.M{"$sum": 1}}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	})
+	if err != nil {
+		return err
+	}
+	var duplicates []struct {
+		IDs    []primitive.ObjectID `bson:"ids"`
+		Latest primitive.ObjectID   `bson:"latest"`
+	}
+	if err := cursor.All(ctx, &duplicates); err != nil {
+		return err
+	}
+	for _, duplicate := range duplicates {
+		result, err := collection.UpdateMany(ctx,
+			bson.M{"_id": bson.M{"$in": duplicate.IDs, "$ne": duplicate.Latest}},
+			bson.M{"$set": bson.M{"status": requestCancelled, "timestamp": time.Now()}},
+		)
+		if err != nil {
+			return err
+		}
+		log.Printf("撤回了 %d 个重复的待处理好友请求", result.ModifiedCount)
+	}
+	return nil
+}
+
+// checkCanRequest 检查 from 是否可以向 to 发送好友请求：不在被拒绝后的冷却期内
+// 双方之间是否已有待处理的请求由唯一索引在写入时保证
+func (s *CustomFriendServiceServer) checkCanRequest(ctx context.Context, from, to string) error {
+	friendRequestsCollection := s.mongoClient.DB.Collection("friend_requests")
+	count, err := friendRequestsCollection.CountDocuments(ctx, bson.M{
+		"from":         from,
+		"to":           to,
+		"status":       requestRejected,
+		"responded_at": bson.M{"$gt": time.Now().Add(-s.rejectCooldown)},
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("好友请求已被拒绝，请稍后再试")
+	}
+	return nil
+}
+
+// transitionRequest 将 from 发给 to 的待处理请求改为 status，返回更新后的请求
+func (s *CustomFriendServiceServer) transitionRequest(ctx context.Context, from, to, status string) (*friendRequestDocument, error) {
+	now := time.Now()
+	set := bson.M{"status": status, "timestamp": now}
+	if status != requestCancelled {
+		set["responded_at"] = now
+	}
+	var doc friendRequestDocument
+	err := s.mongoClient.DB.Collection("friend_requests").FindOneAndUpdate(ctx,
+		bson.M{"from": from, "to": to, "status": requestPending},
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetSort(bson.D{{Key: "_id", Value: -1}}).SetReturnDocument(options.After),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// notifyRequest 推送好友请求的状态变化，失败只记录日志
+func (s *CustomFriendServiceServer) notifyRequest(doc *friendRequestDocument) {
+	if err := s.kafkaProducer.SendFriendRequestChange(doc.ID.Hex(), doc.From, doc.To, doc.Status); err != nil {
+		log.Printf("发送好友请求状态变化事件到 Kafka 失败: %v", err)
+	}
+}
+
+// RejectFriendRequest 拒绝好友请求，冷却期内发送者不能再次发送
+func (s *CustomFriendServiceServer) RejectFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.To {
+		return &FriendRequestResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := s.transitionRequest(ctx, req.From, req.To, requestRejected)
+	if err != nil {
+		return &FriendRequestResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	s.notifyRequest(doc)
+
+	return &FriendRequestResponse{Success: true}, nil
+}
+
+// CancelFriendRequest 发送者撤回尚未处理的好友请求
+func (s *CustomFriendServiceServer) CancelFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.From {
+		return &FriendRequestResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := s.transitionRequest(ctx, req.From, req.To, requestCancelled)
+	if err != nil {
+		return &FriendRequestResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	s.notifyRequest(doc)
+
+	return &FriendRequestResponse{Success: true}, nil
+}
+
+// ListIncomingFriendRequests 查询发给自己的好友请求
+func (s *CustomFriendServiceServer) ListIncomingFriendRequests(ctx context.Context, req *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
+	return s.listRequests(ctx, req, "to")
+}
+
+// ListOutgoingFriendRequests 查询自己发出的好友请求
+func (s *CustomFriendServiceServer) ListOutgoingFriendRequests(ctx context.Context, req *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
+	return s.listRequests(ctx, req, "from")
+}
+
+// listRequests 按 field（to 或 from）查询用户的好友请求，按 _id 倒序分页，游标为上一页最后一条请求的 ID
+func (s *CustomFriendServiceServer) listRequests(ctx context.Context, req *ListFriendRequestsRequest, field string) (*ListFriendRequestsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &ListFriendRequestsResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	filter := bson.M{field: req.Username}
+	if len(req.Statuses) > 0 {
+		statuses := make([]string, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, strings.ToLower(status.String()))
+		}
+		filter["status"] = bson.M{"$in": statuses}
+	}
+	if req.Before != "" {
+		before, err := primitive.ObjectIDFromHex(req.Before)
+		if err != nil {
+			return &ListFriendRequestsResponse{
+				Success:  false,
+				ErrorMsg: errInvalidCursor.Error(),
+			}, nil
+		}
+		filter["_id"] = bson.M{"$lt": before}
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRequestLimit
+	}
+	if limit > maxRequestLimit {
+		limit = maxRequestLimit
+	}
+
+	// 多查一条判断是否还有下一页
+	cursor, err := s.mongoClient.DB.Collection("friend_requests").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(int64(limit)+1),
+	)
+	if err != nil {
+		return &ListFriendRequestsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var docs []friendRequestDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return &ListFriendRequestsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	resp := &ListFriendRequestsResponse{Success: true}
+	if len(docs) > int(limit) {
+		docs = docs[:limit]
+		resp.HasMore = true
+		resp.NextCursor = docs[len(docs)-1].ID.Hex()
+	}
+	resp.Requests = make([]*FriendRequestItem, 0, len(docs))
+	for i := range docs {
+		resp.Requests = append(resp.Requests, docs[i].toFriendRequestItem())
+	}
+	return resp, nil
+}
@@ -3,10 +3,11 @@ package friend
 import (
 	"context"
 	"errors"
-	"fmt"
-	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"im-service/internal/data/kafka"
 	"im-service/internal/data/mongodb"
+	"im-service/internal/data/mysql"
 	"im-service/internal/data/redis"
 	"log"
 	"time"
@@ -17,7 +18,9 @@ type CustomFriendServiceServer struct {
 	kafkaProducer *kafka.KafkaProducer
 	mongoClient   *mongodb.MongoClient
 	redisClient   *redis.RedisClient
-	kafkaConsumer *kafka.KafkaConsumer
+	mysqlClient   *mysql.MySQLClient
+	// rejectCooldown 好友请求被拒绝后，发送者再次发送需要等待的时间
+	rejectCooldown time.Duration
 }
 
 func (s *CustomFriendServiceServer) mustEmbedUnimplementedFriendServiceServer() {
@@ -26,36 +29,58 @@ func (s *CustomFriendServiceServer) mustEmbedUnimplementedFriendServiceServer()
 }
 
 // NewCustomFriendServiceServer 创建好友服务端实例
-func NewCustomFriendServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, kafkaConsumer *kafka.KafkaConsumer) *CustomFriendServiceServer {
+func NewCustomFriendServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, mysqlClient *mysql.MySQLClient, rejectCooldown time.Duration) *CustomFriendServiceServer {
+	if rejectCooldown <= 0 {
+		rejectCooldown = defaultRejectCooldown
+	}
 	return &CustomFriendServiceServer{
-		kafkaProducer: kafkaProducer,
-		mongoClient:   mongoClient,
-		redisClient:   redisClient,
-		kafkaConsumer: kafkaConsumer,
+		kafkaProducer:  kafkaProducer,
+		mongoClient:    mongoClient,
+		redisClient:    redisClient,
+		mysqlClient:    mysqlClient,
+		rejectCooldown: rejectCooldown,
 	}
 }
 
-// SendFriendRequest 处理发送好友请求
-func (s *CustomFriendServiceServer) SendFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
-	// 生成请求 ID
+// friendIdempotencyTTL 发送和同意好友请求的幂等记录保留时间
+const friendIdempotencyTTL = 10 * time.Minute
+
+// idempotencyKey 返回好友请求操作的幂等键，由操作、双方和客户端请求 ID 组成
+func idempotencyKey(op string, req *FriendRequest) string {
+	return "im:idempotency:friend:" + op + ":" + req.From + ":" + req.To + ":" + req.ClientRequestId
+}
 
-	requestID := fmt.Sprintf(req.From, "_addFriend")
-	// 检查并设置幂等性键
-	exists, err := s.redisClient.CheckAndSetIdempotency(requestID, 10*time.Minute)
+// idempotent 以 (from, to, 客户端请求 ID) 去重后执行 fn，重复的请求直接返回成功；
+// fn 失败时删除幂等记录，客户端可以用同一个请求 ID 重试。没有客户端请求 ID 时不做幂等检查
+func (s *CustomFriendServiceServer) idempotent(ctx context.Context, op string, req *FriendRequest, fn func(context.Context, *FriendRequest) (*FriendRequestResponse, error)) (*FriendRequestResponse, error) {
+	if req.ClientRequestId == "" {
+		return fn(ctx, req)
+	}
+	key := idempotencyKey(op, req)
+	first, err := s.redisClient.CheckAndSetIdempotency(key, friendIdempotencyTTL)
 	if err != nil {
 		return nil, err
 	}
-	if !exists {
+	if !first {
 		// 请求已经处理过，直接返回成功
 		return &FriendRequestResponse{
 			Success:  true,
 			ErrorMsg: "",
 		}, nil
 	}
+	resp, err := fn(ctx, req)
+	if err != nil || !resp.Success {
+		if err := s.redisClient.ReleaseIdempotency(key); err != nil {
+			log.Printf("删除幂等记录失败: %v", err)
+		}
+	}
+	return resp, err
+}
 
+// SendFriendRequest 处理发送好友请求
+func (s *CustomFriendServiceServer) SendFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
 	//从上下文中获取用户名
 	username := ctx.Value("username")
-	log.Println(username)
 	//验证用户
 	if username != req.From {
 		return &FriendRequestResponse{
@@ -63,8 +88,13 @@ func (s *CustomFriendServiceServer) SendFriendRequest(ctx context.Context, req *
 			ErrorMsg: "你不是用户本人",
 		}, errors.New("验证用户出错")
 	}
+	return s.idempotent(ctx, "add", req, s.sendFriendRequest)
+}
+
+// sendFriendRequest 检查双方关系后写入待处理的好友请求并通知接收者
+func (s *CustomFriendServiceServer) sendFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
 	// 检查发送者和接收者是否为好友
-	isFriend, err := IsFriends(ctx, s.mongoClient, req.From, req.To)
+	isFriend, err := IsFriends(ctx, s.mongoClient, s.redisClient, req.From, req.To)
 
 	if err != nil {
 		log.Printf("检查好友关系时出错: %v", err)
@@ -79,34 +109,41 @@ func (s *CustomFriendServiceServer) SendFriendRequest(ctx context.Context, req *
 			ErrorMsg: "发送者和接收者已经是好友，无法发送好友申请",
 		}, err
 	}
-	// 发送消息到 Kafka
-	content := "有新的好友请求"
-	command := "sendMessage"
-	kafkaMessage := fmt.Sprintf("%s|%s|%s|%s", command, req.From, req.To, content)
-	err = s.kafkaProducer.SendMessage(kafkaMessage)
-	if err != nil {
-		log.Printf("发送消息到 Kafka 失败: %v", err)
+	// 被对方拉黑时返回通用错误
+	if err := CheckBlocked(ctx, s.mongoClient, req.From, req.To); err != nil {
+		return &FriendRequestResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err := s.checkCanRequest(ctx, req.From, req.To); err != nil {
 		return &FriendRequestResponse{
 			Success:  false,
 			ErrorMsg: err.Error(),
 		}, nil
 	}
-	log.Printf("消息成功发送到 Kafka，内容: %s", kafkaMessage)
 	// 插入好友请求到 MongoDB
 	friendRequestsCollection := s.mongoClient.DB.Collection("friend_requests")
-	friendRequest := bson.M{
-		"from":      req.From,
-		"to":        req.To,
-		"status":    "pending",
-		"timestamp": time.Now(),
+	friendRequest := &friendRequestDocument{
+		ID:        primitive.NewObjectID(),
+		From:      req.From,
+		To:        req.To,
+		Pair:      pairKey(req.From, req.To),
+		Status:    requestPending,
+		Timestamp: time.Now(),
 	}
 	_, insertErr := friendRequestsCollection.InsertOne(ctx, friendRequest)
+	if mongo.IsDuplicateKeyError(insertErr) {
+		insertErr = errPendingRequest
+	}
 	if insertErr != nil {
 		return &FriendRequestResponse{
 			Success:  false,
 			ErrorMsg: insertErr.Error(),
 		}, nil
 	}
+	// 通知接收者有新的好友请求
+	s.notifyRequest(friendRequest)
 
 	return &FriendRequestResponse{
 		Success:  true,
@@ -116,20 +153,6 @@ func (s *CustomFriendServiceServer) SendFriendRequest(ctx context.Context, req *
 
 // AcceptFriendRequest 处理同意好友请求
 func (s *CustomFriendServiceServer) AcceptFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
-	// 生成请求 ID
-	requestID := fmt.Sprintf(req.To, "_acceptFriend")
-	// 检查并设置幂等性键
-	exists, err := s.redisClient.CheckAndSetIdempotency(requestID, 10*time.Minute)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		// 请求已经处理过，直接返回成功
-		return &FriendRequestResponse{
-			Success:  true,
-			ErrorMsg: "",
-		}, nil
-	}
 	//从上下文中获取用户名
 	username := ctx.Value("username")
 	//验证用户
@@ -139,8 +162,13 @@ func (s *CustomFriendServiceServer) AcceptFriendRequest(ctx context.Context, req
 			ErrorMsg: "你不是用户本人",
 		}, nil
 	}
+	return s.idempotent(ctx, "accept", req, s.acceptFriendRequest)
+}
+
+// acceptFriendRequest 将待处理的好友请求标记为已接受并建立好友关系
+func (s *CustomFriendServiceServer) acceptFriendRequest(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
 	// 检查发送者和接收者是否为好友
-	isFriend, err := IsFriends(ctx, s.mongoClient, req.From, req.To)
+	isFriend, err := IsFriends(ctx, s.mongoClient, s.redisClient, req.From, req.To)
 
 	if err != nil {
 		log.Printf("检查好友关系时出错: %v", err)
@@ -155,41 +183,24 @@ func (s *CustomFriendServiceServer) AcceptFriendRequest(ctx context.Context, req
 			ErrorMsg: "发送者和接收者已经是好友，无法处理好友申请",
 		}, err
 	}
-	// 更新好友请求状态为已接受
-	friendRequestsCollection := s.mongoClient.DB.Collection("friend_requests")
-	filter := bson.M{
-		"from":   req.From,
-		"to":     req.To,
-		"status": "pending",
-	}
-	update := bson.M{
-		"$set": bson.M{
-			"status":    "accepted",
-			"timestamp": time.Now(),
-		},
-	}
-	_, updateErr := friendRequestsCollection.UpdateOne(ctx, filter, update)
+	// 更新好友请求状态为已接受并建立好友关系，两者在同一事务中写入；没有待处理的请求时不能建立好友关系
+	var request *friendRequestDocument
+	updateErr := withTransaction(ctx, s.mongoClient, func(sessCtx mongo.SessionContext) error {
+		var err error
+		request, err = s.transitionRequest(sessCtx, req.From, req.To, requestAccepted)
+		if err != nil {
+			return err
+		}
+		return addFriendship(sessCtx, s.mongoClient, req.From, req.To, request.ID)
+	})
 	if updateErr != nil {
 		return &FriendRequestResponse{
 			Success:  false,
 			ErrorMsg: updateErr.Error(),
 		}, nil
 	}
-
-	// 插入好友关系到 MongoDB
-	friendsCollection := s.mongoClient.DB.Collection("friends")
-	friend := bson.M{
-		"user1":     req.From,
-		"user2":     req.To,
-		"timestamp": time.Now(),
-	}
-	_, insertErr := friendsCollection.InsertOne(ctx, friend)
-	if insertErr != nil {
-		return &FriendRequestResponse{
-			Success:  false,
-			ErrorMsg: insertErr.Error(),
-		}, nil
-	}
+	invalidateFriends(ctx, s.redisClient, req.From, req.To)
+	s.notifyRequest(request)
 
 	// 发送好友关系建立通知到 Kafka
 	err = s.kafkaProducer.SendFriendAcceptedNotification(req.From, req.To)
@@ -200,52 +211,12 @@ func (s *CustomFriendServiceServer) AcceptFriendRequest(ctx context.Context, req
 		}, nil
 	}
 
-	go func() {
-		err := s.kafkaConsumer.ConsumeMessages()
-		if err != nil {
-			log.Printf("Kafka 消费者出现错误")
-			log.Println(err)
-		}
-	}()
-
 	return &FriendRequestResponse{
 		Success:  true,
 		ErrorMsg: "",
 	}, nil
 }
 
-// IsFriends  检查两个用户是否为好友
-func IsFriends(ctx context.Context, mongoClient *mongodb.MongoClient, user1, user2 string) (bool, error) {
-	log.Printf("检查发送者和接收者是否为好友")
-	// 获取好友关系集合
-	friendshipsCollection := mongoClient.DB.Collection("friend_requests")
-
-	// 构建查询条件，检查用户 1 和用户 2 之间是否存在已接受的好友关系
-	filter := bson.M{
-		"$or": []bson.M{
-			{
-				"from":   user1,
-				"to":     user2,
-				"status": "accepted",
-			},
-			{
-				"from":   user2,
-				"to":     user1,
-				"status": "accepted",
-			},
-		},
-	}
-
-	// 执行查询
-	count, err := friendshipsCollection.CountDocuments(ctx, filter)
-	if err != nil {
-		return false, err
-	}
-
-	// 如果存在已接受的好友关系，则返回 true，否则返回 false
-	return count > 0, nil
-}
-
 // GetFriendList 处理获取好友列表请求
 func (s *CustomFriendServiceServer) GetFriendList(ctx context.Context, req *GetFriendListRequest) (*GetFriendListResponse, error) {
 	//从上下文中获取用户名
@@ -259,23 +230,7 @@ func (s *CustomFriendServiceServer) GetFriendList(ctx context.Context, req *GetF
 	}
 
 	log.Printf("准备获取好友列表")
-	// 获取好友关系集合
-	friendsCollection := s.mongoClient.DB.Collection("friends")
-
-	// 构建查询条件，查找用户的所有好友
-	filter := bson.M{
-		"$or": []bson.M{
-			{
-				"user1": req.Username,
-			},
-			{
-				"user2": req.Username,
-			},
-		},
-	}
-	log.Printf("查询用户好友")
-	// 执行查询
-	cursor, err := friendsCollection.Find(ctx, filter)
+	docs, err := listFriendships(ctx, s.mongoClient, req.Username)
 	if err != nil {
 		log.Printf("查询好友列表时出错: %v", err)
 		return &GetFriendListResponse{
@@ -283,29 +238,65 @@ func (s *CustomFriendServiceServer) GetFriendList(ctx context.Context, req *GetF
 			ErrorMsg: err.Error(),
 		}, err
 	}
-	defer cursor.Close(ctx)
-
-	var friends []bson.M
-	if err := cursor.All(ctx, &friends); err != nil {
-		log.Printf("获取好友列表时出错: %v", err)
+	settings, err := s.friendSettings(ctx, req.Username)
+	if err != nil {
+		log.Printf("查询好友设置时出错: %v", err)
 		return &GetFriendListResponse{
 			Success:  false,
 			ErrorMsg: err.Error(),
 		}, err
 	}
 
-	var friendUsernames []string
-	for _, friend := range friends {
-		if friend["user1"] == req.Username {
-			friendUsernames = append(friendUsernames, friend["user2"].(string))
-		} else {
-			friendUsernames = append(friendUsernames, friend["user1"].(string))
+	// 按标签过滤，并记录成为好友的时间用于排序
+	addedAt := make(map[string]time.Time, len(docs))
+	filtered := docs[:0]
+	for _, doc := range docs {
+		friend := doc.friend(req.Username)
+		if req.Tag != "" && (settings[friend] == nil || !settings[friend].hasTag(req.Tag)) {
+			continue
 		}
+		addedAt[friend] = doc.Timestamp
+		filtered = append(filtered, doc)
 	}
+	friends := s.friendEntries(ctx, req.Username, filtered, settings)
+	sortFriendEntries(friends, addedAt, req.Sort)
 
+	friendUsernames := make([]string, 0, len(friends))
+	for _, entry := range friends {
+		friendUsernames = append(friendUsernames, entry.Username)
+	}
 	return &GetFriendListResponse{
 		FriendUsernames: friendUsernames,
+		Friends:         friends,
 		Success:         true,
 		ErrorMsg:        "",
 	}, nil
 }
+
+// DeleteFriend 删除好友，双方的好友关系同时解除，只通知操作者的其他设备
+func (s *CustomFriendServiceServer) DeleteFriend(ctx context.Context, req *DeleteFriendRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	err := withTransaction(ctx, s.mongoClient, func(sessCtx mongo.SessionContext) error {
+		return endFriendship(sessCtx, s.mongoClient, req.Username, req.Target)
+	})
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	invalidateFriends(ctx, s.redisClient, req.Username, req.Target)
+
+	if err := s.kafkaProducer.SendFriendRemoved(req.Username, req.Target); err != nil {
+		log.Printf("发送删除好友事件到 Kafka 失败: %v", err)
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
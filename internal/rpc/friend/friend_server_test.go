@@ -0,0 +1,63 @@
+package friend
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"im-service/internal/data/redis"
+	"testing"
+)
+
+func TestIdempotent(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := &CustomFriendServiceServer{redisClient: redis.NewRedisClient(mr.Addr(), "")}
+	ctx := context.Background()
+
+	calls := 0
+	succeed := true
+	fn := func(ctx context.Context, req *FriendRequest) (*FriendRequestResponse, error) {
+		calls++
+		if !succeed {
+			return &FriendRequestResponse{Success: false, ErrorMsg: "失败"}, nil
+		}
+		return &FriendRequestResponse{Success: true}, nil
+	}
+
+	// 按顺序执行，calls 为执行到该步时 fn 被调用的总次数
+	steps := []struct {
+		name      string
+		req       *FriendRequest
+		fail      bool
+		wantCalls int
+	}{
+		{name: "第一次请求", req: &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r1"}, wantCalls: 1},
+		{name: "重试同一个请求", req: &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r1"}, wantCalls: 1},
+		{name: "同一个请求 ID 发给其他用户", req: &FriendRequest{From: "alice", To: "carol", ClientRequestId: "r1"}, wantCalls: 2},
+		{name: "其他用户使用相同的请求 ID", req: &FriendRequest{From: "dave", To: "bob", ClientRequestId: "r1"}, wantCalls: 3},
+		{name: "新的请求 ID", req: &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r2"}, wantCalls: 4},
+		{name: "处理失败", req: &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r3"}, fail: true, wantCalls: 5},
+		{name: "失败后可以重试", req: &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r3"}, wantCalls: 6},
+		{name: "没有请求 ID 时不去重", req: &FriendRequest{From: "alice", To: "bob"}, wantCalls: 7},
+		{name: "没有请求 ID 时再次执行", req: &FriendRequest{From: "alice", To: "bob"}, wantCalls: 8},
+	}
+	for _, step := range steps {
+		succeed = !step.fail
+		resp, err := s.idempotent(ctx, "add", step.req, fn)
+		if err != nil {
+			t.Fatalf("%s: idempotent() error = %v", step.name, err)
+		}
+		if resp.Success == step.fail {
+			t.Fatalf("%s: Success = %v, want %v", step.name, resp.Success, !step.fail)
+		}
+		if calls != step.wantCalls {
+			t.Fatalf("%s: fn 调用了 %d 次, want %d", step.name, calls, step.wantCalls)
+		}
+	}
+
+	// 发送和同意使用不同的幂等键
+	if _, err := s.idempotent(ctx, "accept", &FriendRequest{From: "alice", To: "bob", ClientRequestId: "r1"}, fn); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 9 {
+		t.Fatalf("同意请求没有执行, calls = %d", calls)
+	}
+}
@@ -0,0 +1,467 @@
+package friend
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"im-service/internal/data/mysql"
+	"im-service/internal/data/redis"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxRemarkLength 备注的最大字符数
+	maxRemarkLength = 32
+	// maxFriendTags 每个好友最多的标签数
+	maxFriendTags = 10
+	// maxTagLength 标签的最大字符数
+	maxTagLength = 16
+)
+
+// friendSettingDocument friend_settings 集合中的文档，owner 对好友 target 的私有设置
+type friendSettingDocument struct {
+	Owner     string    `bson:"owner"`
+	Target    string    `bson:"target"`
+	Remark    string    `bson:"remark,omitempty"`
+	Tags      []string  `bson:"tags,omitempty"`
+	Starred   bool      `bson:"starred,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// hasTag 判断设置中是否带有标签 tag
+func (d *friendSettingDocument) hasTag(tag string) bool {
+	for _, t := range d.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTag 去掉标签首尾的空白并检查长度
+func normalizeTag(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", errors.New("标签不能为空")
+	}
+	if utf8.RuneCountInString(tag) > maxTagLength {
+		return "", errors.New("标签过长")
+	}
+	return tag, nil
+}
+
+// normalizeTags 规范化好友的标签列表：去掉空白和空标签，去重并保持原有顺序
+func normalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	if len(result) > maxFriendTags {
+		return nil, errors.New("标签过多")
+	}
+	return result, nil
+}
+
+// friendSettings 查询 owner 对好友的私有设置，targets 为空时查询全部
+func (s *CustomFriendServiceServer) friendSettings(ctx context.Context, owner string, targets ...string) (map[string]*friendSettingDocument, error) {
+	filter := bson.M{"owner": owner}
+	if len(targets) > 0 {
+		filter["target"] = bson.M{"$in": targets}
+	}
+	cursor, err := s.mongoClient.DB.Collection("friend_settings").Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var docs []friendSettingDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	settings := make(map[string]*friendSettingDocument, len(docs))
+	for i := range docs {
+		settings[docs[i].Target] = &docs[i]
+	}
+	return settings, nil
+}
+
+// nicknames 从 MySQL 查询用户的昵称，失败只记录日志
+func (s *CustomFriendServiceServer) nicknames(ctx context.Context, usernames []string) map[string]string {
+	nicknames := make(map[string]string, len(usernames))
+	if len(usernames) == 0 {
+		return nicknames
+	}
+	var users []mysql.User
+	err := s.mysqlClient.DB.WithContext(ctx).Select("username", "nickname").Where("username IN ?", usernames).Find(&users).Error
+	if err != nil {
+		log.Printf("查询用户昵称失败: %v", err)
+		return nicknames
+	}
+	for _, user := range users {
+		nicknames[user.Username] = user.Nickname
+	}
+	return nicknames
+}
+
+// friendPresence 查询好友的在线状态和最后在线时间，对方隐藏在线状态时显示为离线
+func (s *CustomFriendServiceServer) friendPresence(ctx context.Context, username string) (FriendPresence, int64) {
+	hidden, err := s.redisClient.IsPresenceHidden(ctx, username)
+	if err != nil || hidden {
+		return FriendPresence_FRIEND_OFFLINE, 0
+	}
+	status, err := s.redisClient.GetPresence(ctx, username)
+	if err != nil {
+		log.Printf("查询用户 %s 在线状态失败: %v", username, err)
+		return FriendPresence_FRIEND_OFFLINE, 0
+	}
+	lastSeen, err := s.redisClient.GetLastSeen(ctx, username)
+	if err != nil {
+		log.Printf("查询用户 %s 最后在线时间失败: %v", username, err)
+	}
+	switch status {
+	case redis.PresenceOnline:
+		return FriendPresence_FRIEND_ONLINE, lastSeen
+	case redis.PresenceAway:
+		return FriendPresence_FRIEND_AWAY, lastSeen
+	default:
+		return FriendPresence_FRIEND_OFFLINE, lastSeen
+	}
+}
+
+// friendEntries 将 owner 的好友关系转换为带昵称、私有设置和在线状态的好友条目
+func (s *CustomFriendServiceServer) friendEntries(ctx context.Context, owner string, docs []friendshipDocument, settings map[string]*friendSettingDocument) []*FriendEntry {
+	usernames := make([]string, 0, len(docs))
+	for i := range docs {
+		usernames = append(usernames, docs[i].friend(owner))
+	}
+	nicknames := s.nicknames(ctx, usernames)
+
+	entries := make([]*FriendEntry, 0, len(docs))
+	for i := range docs {
+		username := docs[i].friend(owner)
+		entry := &FriendEntry{
+			Username: username,
+			Nickname: nicknames[username],
+			AddedAt:  docs[i].Timestamp.Format(time.RFC3339),
+		}
+		if setting, ok := settings[username]; ok {
+			entry.Remark = setting.Remark
+			entry.Tags = setting.Tags
+			entry.Starred = setting.Starred
+		}
+		entry.Presence, entry.LastSeen = s.friendPresence(ctx, username)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// displayName 返回好友的显示名称：备注、昵称、用户名中第一个非空的
+func displayName(entry *FriendEntry) string {
+	if entry.Remark != "" {
+		return entry.Remark
+	}
+	if entry.Nickname != "" {
+		return entry.Nickname
+	}
+	return entry.Username
+}
+
+// sortFriendEntries 按 order 对好友条目排序，addedAt 为各好友成为好友的时间
+func sortFriendEntries(entries []*FriendEntry, addedAt map[string]time.Time, order FriendListSort) {
+	if order == FriendListSort_FRIEND_SORT_PINYIN {
+		// 中文按拼音顺序；Collator 不能并发使用，每次排序单独创建
+		collator := collate.New(language.Chinese)
+		sort.SliceStable(entries, func(i, j int) bool {
+			a, b := displayName(entries[i]), displayName(entries[j])
+			if a == b {
+				return entries[i].Username < entries[j].Username
+			}
+			return collator.CompareString(a, b) < 0
+		})
+		return
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return addedAt[entries[i].Username].After(addedAt[entries[j].Username])
+	})
+}
+
+// UpdateFriend 修改好友的备注、标签和星标，只对自己可见
+func (s *CustomFriendServiceServer) UpdateFriend(ctx context.Context, req *UpdateFriendRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	fields := bson.M{}
+	if req.Remark != nil {
+		remark := strings.TrimSpace(*req.Remark)
+		if utf8.RuneCountInString(remark) > maxRemarkLength {
+			return &FriendOperationResponse{
+				Success:  false,
+				ErrorMsg: "备注过长",
+			}, nil
+		}
+		fields["remark"] = remark
+	}
+	if req.Starred != nil {
+		fields["starred"] = *req.Starred
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(req.Tags.Tags)
+		if err != nil {
+			return &FriendOperationResponse{
+				Success:  false,
+				ErrorMsg: err.Error(),
+			}, nil
+		}
+		fields["tags"] = tags
+	}
+	if len(fields) == 0 {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "没有需要修改的内容",
+		}, nil
+	}
+
+	isFriend, err := IsFriends(ctx, s.mongoClient, s.redisClient, req.Username, req.Target)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if !isFriend {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: errNotFriends.Error(),
+		}, nil
+	}
+
+	fields["updated_at"] = time.Now()
+	_, err = s.mongoClient.DB.Collection("friend_settings").UpdateOne(ctx,
+		bson.M{"owner": req.Username, "target": req.Target},
+		bson.M{"$set": fields},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
+
+// GetFriend 查询单个好友的昵称、私有设置和在线状态
+func (s *CustomFriendServiceServer) GetFriend(ctx context.Context, req *GetFriendRequest) (*GetFriendResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetFriendResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	var doc friendshipDocument
+	err := s.mongoClient.DB.Collection("friends").FindOne(ctx, bson.M{"pair": pairKey(req.Username, req.Target)}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &GetFriendResponse{
+			Success:  false,
+			ErrorMsg: errNotFriends.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &GetFriendResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	settings, err := s.friendSettings(ctx, req.Username, req.Target)
+	if err != nil {
+		return &GetFriendResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	return &GetFriendResponse{
+		Friend:  s.friendEntries(ctx, req.Username, []friendshipDocument{doc}, settings)[0],
+		Success: true,
+	}, nil
+}
+
+// ListFriendTags 查询自己使用的所有标签及其下的好友数
+func (s *CustomFriendServiceServer) ListFriendTags(ctx context.Context, req *ListFriendTagsRequest) (*ListFriendTagsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &ListFriendTagsResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	cursor, err := s.mongoClient.DB.Collection("friend_settings").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"owner": req.Username}}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return &ListFriendTagsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var results []struct {
+		Name  string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return &ListFriendTagsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	tags := make([]*FriendTag, 0, len(results))
+	for _, result := range results {
+		tags = append(tags, &FriendTag{Name: result.Name, Count: result.Count})
+	}
+	collator := collate.New(language.Chinese)
+	sort.Slice(tags, func(i, j int) bool {
+		return collator.CompareString(tags[i].Name, tags[j].Name) < 0
+	})
+	return &ListFriendTagsResponse{
+		Tags:    tags,
+		Success: true,
+	}, nil
+}
+
+// RenameFriendTag 重命名标签，新名称已存在时两个标签合并
+func (s *CustomFriendServiceServer) RenameFriendTag(ctx context.Context, req *RenameFriendTagRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	// 保存的标签都经过规范化，旧名称也按同样规则处理后再比较和查询
+	oldName, err := normalizeTag(req.Tag)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	newName, err := normalizeTag(req.NewName)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if newName == oldName {
+		return &FriendOperationResponse{Success: true}, nil
+	}
+
+	settingsCollection := s.mongoClient.DB.Collection("friend_settings")
+	filter := bson.M{"owner": req.Username, "tags": oldName}
+	var matched int64
+	err = withTransaction(ctx, s.mongoClient, func(sessCtx mongo.SessionContext) error {
+		// 同一次更新不能对 tags 同时使用 $addToSet 和 $pull，分两步在事务中完成；
+		// 每个好友的标签数保持不变，已有新名称时合并后减少一个
+		result, err := settingsCollection.UpdateMany(sessCtx, filter, bson.M{
+			"$addToSet": bson.M{"tags": newName},
+			"$set":      bson.M{"updated_at": time.Now()},
+		})
+		if err != nil {
+			return err
+		}
+		matched = result.MatchedCount
+		_, err = settingsCollection.UpdateMany(sessCtx, filter, bson.M{"$pull": bson.M{"tags": oldName}})
+		return err
+	})
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if matched == 0 {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "标签不存在",
+		}, nil
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
+
+// DeleteFriendTag 删除标签，只从好友上移除该标签
+func (s *CustomFriendServiceServer) DeleteFriendTag(ctx context.Context, req *DeleteFriendTagRequest) (*FriendOperationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	tag, err := normalizeTag(req.Tag)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	result, err := s.mongoClient.DB.Collection("friend_settings").UpdateMany(ctx,
+		bson.M{"owner": req.Username, "tags": tag},
+		bson.M{
+			"$pull": bson.M{"tags": tag},
+			"$set":  bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if result.MatchedCount == 0 {
+		return &FriendOperationResponse{
+			Success:  false,
+			ErrorMsg: "标签不存在",
+		}, nil
+	}
+	return &FriendOperationResponse{Success: true}, nil
+}
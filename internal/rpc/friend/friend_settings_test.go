@@ -0,0 +1,84 @@
+package friend
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tooMany := make([]string, maxFriendTags+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("标", i+1)
+	}
+	tests := []struct {
+		name    string
+		tags    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "空列表", tags: nil, want: []string{}},
+		{name: "去掉首尾空白", tags: []string{" 同事 ", "家人"}, want: []string{"同事", "家人"}},
+		{name: "跳过空标签", tags: []string{"", "  ", "同学"}, want: []string{"同学"}},
+		{name: "去重并保持顺序", tags: []string{"家人", "同事", " 家人"}, want: []string{"家人", "同事"}},
+		{name: "长度刚好在上限", tags: []string{strings.Repeat("长", maxTagLength)}, want: []string{strings.Repeat("长", maxTagLength)}},
+		{name: "标签过长", tags: []string{strings.Repeat("长", maxTagLength+1)}, wantErr: true},
+		{name: "标签数刚好在上限", tags: tooMany[:maxFriendTags], want: tooMany[:maxFriendTags]},
+		{name: "标签过多", tags: tooMany, wantErr: true},
+		{name: "去重后不超过上限", tags: append(slices.Clone(tooMany[:maxFriendTags]), tooMany[0]), want: tooMany[:maxFriendTags]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeTags(%q) error = %v, wantErr %v", tt.tags, err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("normalizeTags(%q) = %q, want %q", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortFriendEntries(t *testing.T) {
+	now := time.Now()
+	newEntries := func() []*FriendEntry {
+		return []*FriendEntry{
+			{Username: "zhang", Nickname: "张三"},
+			{Username: "li", Remark: "李四"},
+			{Username: "wang", Nickname: "王五"},
+			{Username: "a2", Nickname: "阿强"},
+			{Username: "a1", Nickname: "阿强"},
+		}
+	}
+	addedAt := map[string]time.Time{
+		"zhang": now.Add(-1 * time.Hour),
+		"li":    now.Add(-3 * time.Hour),
+		"wang":  now,
+		"a1":    now.Add(-2 * time.Hour),
+		"a2":    now.Add(-4 * time.Hour),
+	}
+	tests := []struct {
+		name  string
+		order FriendListSort
+		want  []string
+	}{
+		{name: "按成为好友的时间倒序", order: FriendListSort_FRIEND_SORT_ADDED_AT, want: []string{"wang", "zhang", "a1", "li", "a2"}},
+		// 阿(a) < 李(li) < 王(wang) < 张(zhang)，显示名称相同时按用户名
+		{name: "按显示名称拼音", order: FriendListSort_FRIEND_SORT_PINYIN, want: []string{"a1", "a2", "li", "wang", "zhang"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := newEntries()
+			sortFriendEntries(entries, addedAt, tt.order)
+			got := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				got = append(got, entry.Username)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("sortFriendEntries() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package friend
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+	"log"
+	"time"
+)
+
+var (
+	errAlreadyFriends = errors.New("你们已经是好友")
+	errNotFriends     = errors.New("你们不是好友")
+)
+
+// friendshipDocument friends 集合中的好友关系，每对用户只有一条，user1 和 user2 按字典序排列
+type friendshipDocument struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	Pair      string             `bson:"pair"`
+	User1     string             `bson:"user1"`
+	User2     string             `bson:"user2"`
+	Timestamp time.Time          `bson:"timestamp"`            // 成为好友的时间
+	RequestID primitive.ObjectID `bson:"request_id,omitempty"` // 建立好友关系的好友请求
+}
+
+// sortedPair 返回按字典序排列的两个用户名
+func sortedPair(user1, user2 string) [2]string {
+	if user1 > user2 {
+		return [2]string{user2, user1}
+	}
+	return [2]string{user1, user2}
+}
+
+// pairKey 返回两个用户的好友关系键，与顺序无关
+func pairKey(user1, user2 string) string {
+	users := sortedPair(user1, user2)
+	return users[0] + ":" + users[1]
+}
+
+// newFriendship 创建两个用户之间的好友关系文档
+func newFriendship(user1, user2 string, requestID primitive.ObjectID, timestamp time.Time) *friendshipDocument {
+	users := sortedPair(user1, user2)
+	return &friendshipDocument{
+		ID:        primitive.NewObjectID(),
+		Pair:      pairKey(user1, user2),
+		User1:     users[0],
+		User2:     users[1],
+		Timestamp: timestamp,
+		RequestID: requestID,
+	}
+}
+
+// friend 返回好友关系中 username 的另一方
+func (d *friendshipDocument) friend(username string) string {
+	if d.User1 == username {
+		return d.User2
+	}
+	return d.User1
+}
+
+// withTransaction 在 MongoDB 事务中执行 fn，好友关系和好友请求状态需要同时写入
+func withTransaction(ctx context.Context, mongoClient *mongodb.MongoClient, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := mongoClient.Client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+// addFriendship 建立好友关系，已经是好友时返回 errAlreadyFriends
+func addFriendship(ctx context.Context, mongoClient *mongodb.MongoClient, user1, user2 string, requestID primitive.ObjectID) error {
+	_, err := mongoClient.DB.Collection("friends").InsertOne(ctx, newFriendship(user1, user2, requestID, time.Now()))
+	if mongo.IsDuplicateKeyError(err) {
+		return errAlreadyFriends
+	}
+	return err
+}
+
+// removeFriendship 解除好友关系，不是好友时返回 errNotFriends
+func removeFriendship(ctx context.Context, mongoClient *mongodb.MongoClient, user1, user2 string) error {
+	result, err := mongoClient.DB.Collection("friends").DeleteOne(ctx, bson.M{"pair": pairKey(user1, user2)})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return errNotFriends
+	}
+	return nil
+}
+
+// endFriendship 在事务中解除好友关系，同时删除双方的备注、标签等设置，已接受的好友请求保留为历史记录并标记删除时间；
+// 不是好友时返回 errNotFriends
+func endFriendship(sessCtx mongo.SessionContext, mongoClient *mongodb.MongoClient, user1, user2 string) error {
+	if err := removeFriendship(sessCtx, mongoClient, user1, user2); err != nil {
+		return err
+	}
+	_, err := mongoClient.DB.Collection("friend_settings").DeleteMany(sessCtx, bson.M{
+		"$or": []bson.M{
+			{"owner": user1, "target": user2},
+			{"owner": user2, "target": user1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("friend_requests").UpdateMany(sessCtx,
+		bson.M{
+			"$or": []bson.M{
+				{"from": user1, "to": user2},
+				{"from": user2, "to": user1},
+			},
+			"status":     requestAccepted,
+			"removed_at": bson.M{"$exists": false},
+		},
+		bson.M{"$set": bson.M{"removed_at": time.Now()}},
+	)
+	return err
+}
+
+// invalidateFriends 好友关系变化后删除双方的好友缓存，失败只记录日志，缓存会在过期后重新加载
+func invalidateFriends(ctx context.Context, redisClient *redis.RedisClient, usernames ...string) {
+	if redisClient == nil {
+		return
+	}
+	if err := redisClient.InvalidateFriends(ctx, usernames...); err != nil {
+		log.Printf("删除好友缓存失败: %v", err)
+	}
+}
+
+// IsFriends  检查两个用户是否为好友，优先读取 Redis 中的好友集合，redisClient 为 nil 时直接查询 MongoDB
+func IsFriends(ctx context.Context, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, user1, user2 string) (bool, error) {
+	if redisClient != nil {
+		isFriend, ok, err := redisClient.IsCachedFriend(ctx, user1, user2)
+		if err != nil {
+			log.Printf("读取好友缓存失败: %v", err)
+		} else if ok {
+			return isFriend, nil
+		}
+		// 缓存未加载时整体加载 user1 的好友集合，后续检查直接命中缓存
+		friends, err := ListFriends(ctx, mongoClient, redisClient, user1)
+		if err != nil {
+			return false, err
+		}
+		for _, friend := range friends {
+			if friend == user2 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	count, err := mongoClient.DB.Collection("friends").CountDocuments(ctx, bson.M{"pair": pairKey(user1, user2)})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// listFriendships 从 MongoDB 查询用户的所有好友关系
+func listFriendships(ctx context.Context, mongoClient *mongodb.MongoClient, username string) ([]friendshipDocument, error) {
+	cursor, err := mongoClient.DB.Collection("friends").Find(ctx, bson.M{
+		"$or": []bson.M{
+			{"user1": username},
+			{"user2": username},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var docs []friendshipDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// ListFriends 返回用户的所有好友，优先读取 Redis 中的好友集合，未加载时从 MongoDB 查询后写入缓存
+func ListFriends(ctx context.Context, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, username string) ([]string, error) {
+	// 先读取版本号再查询 MongoDB，查询期间好友关系发生变化时不写入缓存
+	var gen string
+	if redisClient != nil {
+		friends, ok, err := redisClient.GetCachedFriends(ctx, username)
+		if err != nil {
+			log.Printf("读取好友缓存失败: %v", err)
+		} else if ok {
+			return friends, nil
+		}
+		if gen, err = redisClient.FriendsGeneration(ctx, username); err != nil {
+			log.Printf("读取好友缓存版本号失败: %v", err)
+		}
+	}
+
+	docs, err := listFriendships(ctx, mongoClient, username)
+	if err != nil {
+		return nil, err
+	}
+	friends := make([]string, 0, len(docs))
+	for i := range docs {
+		friends = append(friends, docs[i].friend(username))
+	}
+
+	if redisClient != nil && gen != "" {
+		if _, err := redisClient.CacheFriends(ctx, username, gen, friends); err != nil {
+			log.Printf("写入好友缓存失败: %v", err)
+		}
+	}
+	return friends, nil
+}
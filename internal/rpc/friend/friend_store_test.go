@@ -0,0 +1,41 @@
+package friend
+
+import "testing"
+
+func TestSortedPair(t *testing.T) {
+	tests := []struct {
+		user1, user2 string
+		want         [2]string
+	}{
+		{user1: "alice", user2: "bob", want: [2]string{"alice", "bob"}},
+		{user1: "bob", user2: "alice", want: [2]string{"alice", "bob"}},
+		{user1: "Bob", user2: "alice", want: [2]string{"Bob", "alice"}},
+		{user1: "alice", user2: "alice", want: [2]string{"alice", "alice"}},
+		{user1: "", user2: "alice", want: [2]string{"", "alice"}},
+	}
+	for _, tt := range tests {
+		if got := sortedPair(tt.user1, tt.user2); got != tt.want {
+			t.Errorf("sortedPair(%q, %q) = %q, want %q", tt.user1, tt.user2, got, tt.want)
+		}
+	}
+}
+
+func TestPairKey(t *testing.T) {
+	tests := []struct {
+		user1, user2 string
+		want         string
+	}{
+		{user1: "alice", user2: "bob", want: "alice:bob"},
+		{user1: "bob", user2: "alice", want: "alice:bob"},
+		{user1: "张三", user2: "李四", want: "张三:李四"},
+		{user1: "李四", user2: "张三", want: "张三:李四"},
+	}
+	for _, tt := range tests {
+		if got := pairKey(tt.user1, tt.user2); got != tt.want {
+			t.Errorf("pairKey(%q, %q) = %q, want %q", tt.user1, tt.user2, got, tt.want)
+		}
+		if got := pairKey(tt.user2, tt.user1); got != tt.want {
+			t.Errorf("pairKey(%q, %q) = %q, want %q", tt.user2, tt.user1, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package friend
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"im-service/internal/data/redis"
+	"im-service/internal/rpc/group"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	// defaultSuggestInterval 未配置时预先计算好友推荐的间隔
+	defaultSuggestInterval = time.Hour
+	// suggestJobName 计算好友推荐任务的名称，用于多节点间领取任务
+	suggestJobName = "suggest_friends"
+	// suggestionsTTL 好友推荐在 Redis 中的保存时间，定时任务会在过期前重新计算
+	suggestionsTTL = 24 * time.Hour
+	// maxSuggestions 每个用户保存的推荐数，也是一次查询的上限
+	maxSuggestions = 50
+	// defaultSuggestLimit 查询好友推荐的默认条数
+	defaultSuggestLimit = 20
+	// maxMutualFriends 每条推荐中保存的共同好友数
+	maxMutualFriends = 10
+	// suggestQueueInterval 检查好友推荐计算队列的间隔
+	suggestQueueInterval = 5 * time.Second
+	// suggestQueueBatch 每次从计算队列中取出的用户数
+	suggestQueueBatch = 100
+)
+
+// suggestionExclusions 不应推荐给 username 的用户：自己、好友、双向拉黑的用户、有待处理请求或冷却期内被拒绝的用户
+func (s *CustomFriendServiceServer) suggestionExclusions(ctx context.Context, username string, friends []string) (map[string]bool, error) {
+	excluded := map[string]bool{username: true}
+	for _, friend := range friends {
+		excluded[friend] = true
+	}
+
+	cursor, err := s.mongoClient.DB.Collection("blocks").Find(ctx, bson.M{
+		"$or": []bson.M{
+			{"owner": username},
+			{"target": username},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var blocks []blockDocument
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		excluded[block.Owner] = true
+		excluded[block.Target] = true
+	}
+
+	cursor, err = s.mongoClient.DB.Collection("friend_requests").Find(ctx, bson.M{
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"from": username},
+				{"to": username},
+			}},
+			{"$or": []bson.M{
+				{"status": requestPending},
+				{"status": requestRejected, "responded_at": bson.M{"$gt": time.Now().Add(-s.rejectCooldown)}},
+			}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var requests []friendRequestDocument
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	for _, request := range requests {
+		excluded[request.From] = true
+		excluded[request.To] = true
+	}
+	return excluded, nil
+}
+
+// computeSuggestions 根据好友关系和群成员关系计算用户的好友推荐，按共同好友数、共同群数倒序
+func (s *CustomFriendServiceServer) computeSuggestions(ctx context.Context, username string) ([]*redis.FriendSuggestion, error) {
+	friends, err := ListFriends(ctx, s.mongoClient, s.redisClient, username)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := s.suggestionExclusions(ctx, username, friends)
+	if err != nil {
+		return nil, err
+	}
+	isFriend := make(map[string]bool, len(friends))
+	for _, friend := range friends {
+		isFriend[friend] = true
+	}
+	candidates := map[string]*redis.FriendSuggestion{}
+	candidate := func(name string) *redis.FriendSuggestion {
+		c, ok := candidates[name]
+		if !ok {
+			c = &redis.FriendSuggestion{Username: name}
+			candidates[name] = c
+		}
+		return c
+	}
+
+	// 好友的好友：好友关系中一方是自己的好友，另一方即为候选人，该好友是共同好友
+	if len(friends) > 0 {
+		cursor, err := s.mongoClient.DB.Collection("friends").Find(ctx, bson.M{
+			"$or": []bson.M{
+				{"user1": bson.M{"$in": friends}},
+				{"user2": bson.M{"$in": friends}},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var docs []friendshipDocument
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			for _, pair := range [][2]string{{doc.User1, doc.User2}, {doc.User2, doc.User1}} {
+				mutual, other := pair[0], pair[1]
+				if isFriend[mutual] && !excluded[other] {
+					c := candidate(other)
+					c.MutualCount++
+					c.MutualFriends = append(c.MutualFriends, mutual)
+				}
+			}
+		}
+	}
+
+	// 同一个群的成员，每个共同群计一次
+	groupIDs, err := group.ListUserGroupIDs(ctx, s.mongoClient, username)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupIDs) > 0 {
+		cursor, err := s.mongoClient.DB.Collection("group_members").Find(ctx, bson.M{
+			"group_id": bson.M{"$in": groupIDs},
+			"username": bson.M{"$ne": username},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var members []struct {
+			Username string `bson:"username"`
+		}
+		if err := cursor.All(ctx, &members); err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if !excluded[member.Username] {
+				candidate(member.Username).SharedGroups++
+			}
+		}
+	}
+
+	suggestions := make([]*redis.FriendSuggestion, 0, len(candidates))
+	for _, c := range candidates {
+		sort.Strings(c.MutualFriends)
+		if len(c.MutualFriends) > maxMutualFriends {
+			c.MutualFriends = c.MutualFriends[:maxMutualFriends]
+		}
+		suggestions = append(suggestions, c)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.MutualCount != b.MutualCount {
+			return a.MutualCount > b.MutualCount
+		}
+		if a.SharedGroups != b.SharedGroups {
+			return a.SharedGroups > b.SharedGroups
+		}
+		return a.Username < b.Username
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return suggestions, nil
+}
+
+// refreshSuggestions 重新计算用户的好友推荐并写入 Redis
+func (s *CustomFriendServiceServer) refreshSuggestions(ctx context.Context, username string) ([]*redis.FriendSuggestion, error) {
+	suggestions, err := s.computeSuggestions(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.redisClient.SetFriendSuggestions(ctx, username, suggestions, suggestionsTTL); err != nil {
+		log.Printf("保存用户 %s 的好友推荐失败: %v", username, err)
+	}
+	return suggestions, nil
+}
+
+// suggestionUsers 返回需要计算好友推荐的用户：有好友或加入了群组的用户
+func (s *CustomFriendServiceServer) suggestionUsers(ctx context.Context) ([]string, error) {
+	seen := map[string]bool{}
+	var users []string
+	for _, source := range []struct{ collection, field string }{
+		{"friends", "user1"},
+		{"friends", "user2"},
+		{"group_members", "username"},
+	} {
+		values, err := s.mongoClient.DB.Collection(source.collection).Distinct(ctx, source.field, bson.M{})
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			if name, ok := value.(string); ok && !seen[name] {
+				seen[name] = true
+				users = append(users, name)
+			}
+		}
+	}
+	return users, nil
+}
+
+// RunSuggester 定期为所有用户预先计算好友推荐，多个节点同时运行时每个周期只有一个节点执行，owner 为本节点的标识
+// 同时处理查询时尚未计算推荐的用户组成的计算队列
+func (s *CustomFriendServiceServer) RunSuggester(ctx context.Context, owner string, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultSuggestInterval
+	}
+	go s.runSuggestionQueue(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// 领取标记略短于间隔，保证下一个周期可以重新领取；执行期间持续续约，超过一个周期时其他节点不会同时执行
+		claimed, err := s.redisClient.ClaimJob(ctx, suggestJobName, owner, interval*9/10)
+		if err != nil {
+			log.Printf("领取好友推荐任务失败: %v", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		jobCtx, release := s.redisClient.HoldJob(ctx, suggestJobName, owner, interval*9/10)
+		s.refreshAllSuggestions(jobCtx)
+		release()
+	}
+}
+
+// refreshAllSuggestions 为所有需要的用户重新计算好友推荐，ctx 取消时中止
+func (s *CustomFriendServiceServer) refreshAllSuggestions(ctx context.Context) {
+	users, err := s.suggestionUsers(ctx)
+	if err != nil {
+		log.Printf("查询需要计算好友推荐的用户失败: %v", err)
+		return
+	}
+	var done, failed int
+	for _, username := range users {
+		if ctx.Err() != nil {
+			log.Printf("好友推荐任务中止，已计算 %d 个用户", done)
+			return
+		}
+		done++
+		if _, err := s.refreshSuggestions(ctx, username); err != nil {
+			failed++
+			log.Printf("计算用户 %s 的好友推荐失败: %v", username, err)
+		}
+	}
+	log.Printf("计算了 %d 个用户的好友推荐，失败 %d 个", len(users), failed)
+}
+
+// runSuggestionQueue 定期为计算队列中的用户计算好友推荐
+func (s *CustomFriendServiceServer) runSuggestionQueue(ctx context.Context) {
+	ticker := time.NewTicker(suggestQueueInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		users, err := s.redisClient.PopFriendSuggestionQueue(ctx, suggestQueueBatch)
+		if err != nil {
+			log.Printf("读取好友推荐计算队列失败: %v", err)
+			continue
+		}
+		for _, username := range users {
+			if _, err := s.refreshSuggestions(ctx, username); err != nil {
+				log.Printf("计算用户 %s 的好友推荐失败: %v", username, err)
+			}
+		}
+	}
+}
+
+// SuggestFriends 返回可能认识的人，只读取预先计算的结果；尚未计算时返回空列表并加入计算队列，不在请求中计算
+// 预先计算之后新加的好友和拉黑的用户在返回前过滤掉
+func (s *CustomFriendServiceServer) SuggestFriends(ctx context.Context, req *SuggestFriendsRequest) (*SuggestFriendsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SuggestFriendsResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestions {
+		limit = maxSuggestions
+	}
+
+	suggestions, ok, err := s.redisClient.GetFriendSuggestions(ctx, req.Username)
+	if err != nil {
+		log.Printf("读取用户 %s 的好友推荐失败: %v", req.Username, err)
+	}
+	if !ok {
+		if err := s.redisClient.QueueFriendSuggestions(ctx, req.Username); err != nil {
+			log.Printf("将用户 %s 加入好友推荐计算队列失败: %v", req.Username, err)
+		}
+		return &SuggestFriendsResponse{
+			Suggestions: []*FriendSuggestion{},
+			Success:     true,
+		}, nil
+	}
+
+	friends, err := ListFriends(ctx, s.mongoClient, s.redisClient, req.Username)
+	if err != nil {
+		return &SuggestFriendsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	excluded, err := s.suggestionExclusions(ctx, req.Username, friends)
+	if err != nil {
+		return &SuggestFriendsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	items := make([]*FriendSuggestion, 0, limit)
+	for _, suggestion := range suggestions {
+		if len(items) >= limit {
+			break
+		}
+		if excluded[suggestion.Username] {
+			continue
+		}
+		items = append(items, &FriendSuggestion{
+			Username:          suggestion.Username,
+			MutualFriendCount: int32(suggestion.MutualCount),
+			MutualFriends:     suggestion.MutualFriends,
+			SharedGroupCount:  int32(suggestion.SharedGroups),
+		})
+	}
+	return &SuggestFriendsResponse{
+		Suggestions: items,
+		Success:     true,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package friend
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"im-service/internal/data/redis"
+	"testing"
+)
+
+func TestSuggestFriendsCacheMiss(t *testing.T) {
+	mr := miniredis.RunT(t)
+	// 没有 MongoDB 客户端，未命中缓存时如果在请求中计算推荐会直接 panic
+	s := &CustomFriendServiceServer{redisClient: redis.NewRedisClient(mr.Addr(), "")}
+	ctx := context.Background()
+
+	for _, username := range []string{"alice", "alice", "bob"} {
+		userCtx := context.WithValue(ctx, "username", username)
+		resp, err := s.SuggestFriends(userCtx, &SuggestFriendsRequest{Username: username})
+		if err != nil {
+			t.Fatalf("SuggestFriends(%s) error = %v", username, err)
+		}
+		if !resp.Success || len(resp.Suggestions) != 0 {
+			t.Fatalf("SuggestFriends(%s) = %+v, want 成功且为空", username, resp)
+		}
+	}
+
+	// 同一用户只排队一次
+	users, err := s.redisClient.PopFriendSuggestionQueue(ctx, suggestQueueBatch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queued := map[string]bool{}
+	for _, username := range users {
+		queued[username] = true
+	}
+	if len(users) != 2 || !queued["alice"] || !queued["bob"] {
+		t.Fatalf("计算队列 = %v, want [alice bob]", users)
+	}
+	users, err = s.redisClient.PopFriendSuggestionQueue(ctx, suggestQueueBatch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 0 {
+		t.Fatalf("取出后计算队列 = %v, want 空", users)
+	}
+}
@@ -0,0 +1,420 @@
+package group
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/kafka"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+	"log"
+	"time"
+)
+
+// 群组事件类型，与推送协议中的 GroupEventType 一致
+const (
+	eventGroupCreated  = "GROUP_CREATED"
+	eventMemberInvited = "MEMBER_INVITED"
+	eventMemberJoined  = "MEMBER_JOINED"
+	eventMemberLeft    = "MEMBER_LEFT"
+	eventMemberKicked  = "MEMBER_KICKED"
+	eventRoleChanged   = "ROLE_CHANGED"
+)
+
+// CustomGroupServiceServer 实现 GroupService 服务
+type CustomGroupServiceServer struct {
+	UnimplementedGroupServiceServer
+	kafkaProducer *kafka.KafkaProducer
+	mongoClient   *mongodb.MongoClient
+	redisClient   *redis.RedisClient
+}
+
+// NewCustomGroupServiceServer 创建群组服务端实例
+func NewCustomGroupServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient) *CustomGroupServiceServer {
+	return &CustomGroupServiceServer{
+		kafkaProducer: kafkaProducer,
+		mongoClient:   mongoClient,
+		redisClient:   redisClient,
+	}
+}
+
+// CreateGroup 创建群组，创建者成为群主
+func (s *CustomGroupServiceServer) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*CreateGroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Owner {
+		return &CreateGroupResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.Name == "" {
+		return &CreateGroupResponse{
+			Success:  false,
+			ErrorMsg: "群名称不能为空",
+		}, nil
+	}
+	if len(req.Members)+1 > maxGroupMembers {
+		return &CreateGroupResponse{
+			Success:  false,
+			ErrorMsg: "群成员数量超过上限",
+		}, nil
+	}
+
+	group := &groupDocument{
+		ID:        primitive.NewObjectID(),
+		Name:      req.Name,
+		Owner:     req.Owner,
+		Public:    req.Public,
+		CreatedAt: time.Now(),
+	}
+	if _, err := s.mongoClient.DB.Collection("groups").InsertOne(ctx, group); err != nil {
+		return &CreateGroupResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	groupID := group.ID.Hex()
+
+	if _, err := addMember(ctx, s.mongoClient, groupID, req.Owner, roleOwner); err != nil {
+		return &CreateGroupResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	for _, member := range req.Members {
+		if member == "" || member == req.Owner {
+			continue
+		}
+		if _, err := addMember(ctx, s.mongoClient, groupID, member, roleMember); err != nil {
+			return &CreateGroupResponse{
+				Success:  false,
+				ErrorMsg: err.Error(),
+			}, nil
+		}
+	}
+
+	s.notifyMembers(ctx, groupID, eventGroupCreated, req.Owner, "", "")
+	return &CreateGroupResponse{
+		Success: true,
+		GroupId: groupID,
+	}, nil
+}
+
+// InviteMembers 邀请用户加入群组，被邀请的用户通过 JoinGroup 加入
+func (s *CustomGroupServiceServer) InviteMembers(ctx context.Context, req *InviteMembersRequest) (*GroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return failed("你不是用户本人"), nil
+	}
+	role, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if role != roleOwner && role != roleAdmin {
+		return failed("只有群主和管理员可以邀请成员"), nil
+	}
+
+	invites := s.mongoClient.DB.Collection("group_invites")
+	for _, invitee := range req.Usernames {
+		if invitee == "" {
+			continue
+		}
+		_, err := invites.UpdateOne(ctx,
+			bson.M{"group_id": req.GroupId, "username": invitee},
+			bson.M{"$set": bson.M{"inviter": req.Username, "created_at": time.Now()}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return failed(err.Error()), nil
+		}
+		s.notifyMembers(ctx, req.GroupId, eventMemberInvited, req.Username, invitee, "")
+	}
+	return succeeded(), nil
+}
+
+// JoinGroup 加入公开群组或已被邀请的群组
+func (s *CustomGroupServiceServer) JoinGroup(ctx context.Context, req *JoinGroupRequest) (*GroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return failed("你不是用户本人"), nil
+	}
+	group, err := findGroup(ctx, s.mongoClient, req.GroupId)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if group == nil {
+		return failed("群组不存在"), nil
+	}
+
+	invites := s.mongoClient.DB.Collection("group_invites")
+	if !group.Public {
+		count, err := invites.CountDocuments(ctx, bson.M{"group_id": req.GroupId, "username": req.Username})
+		if err != nil {
+			return failed(err.Error()), nil
+		}
+		if count == 0 {
+			return failed("该群组需要邀请才能加入"), nil
+		}
+	}
+	// 成员数检查和加入在同一个事务中，并发加入不会超过上限
+	joined, err := addMemberLimited(ctx, s.mongoClient, req.GroupId, req.Username, roleMember)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if _, err := invites.DeleteOne(ctx, bson.M{"group_id": req.GroupId, "username": req.Username}); err != nil {
+		log.Printf("删除群组 %s 的邀请失败: %v", req.GroupId, err)
+	}
+	if joined {
+		s.notifyMembers(ctx, req.GroupId, eventMemberJoined, req.Username, req.Username, roleMember)
+	}
+	return succeeded(), nil
+}
+
+// LeaveGroup 退出群组，群主需要先转让群主
+func (s *CustomGroupServiceServer) LeaveGroup(ctx context.Context, req *LeaveGroupRequest) (*GroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return failed("你不是用户本人"), nil
+	}
+	role, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if role == "" {
+		return failed("你不是群成员"), nil
+	}
+	if role == roleOwner {
+		return failed("群主需要先转让群主才能退出"), nil
+	}
+
+	if err := removeMember(ctx, s.mongoClient, req.GroupId, req.Username); err != nil {
+		return failed(err.Error()), nil
+	}
+	s.notifyMembers(ctx, req.GroupId, eventMemberLeft, req.Username, req.Username, "")
+	return succeeded(), nil
+}
+
+// KickMember 移除群成员
+func (s *CustomGroupServiceServer) KickMember(ctx context.Context, req *KickMemberRequest) (*GroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return failed("你不是用户本人"), nil
+	}
+	if req.Target == req.Username {
+		return failed("不能移除自己"), nil
+	}
+	role, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	targetRole, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Target)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if targetRole == "" {
+		return failed("对方不是群成员"), nil
+	}
+	// 群主可以移除任何人，管理员只能移除普通成员
+	if !(role == roleOwner || (role == roleAdmin && targetRole == roleMember)) {
+		return failed("没有权限移除该成员"), nil
+	}
+
+	if err := removeMember(ctx, s.mongoClient, req.GroupId, req.Target); err != nil {
+		return failed(err.Error()), nil
+	}
+	s.notifyMembers(ctx, req.GroupId, eventMemberKicked, req.Username, req.Target, "")
+	return succeeded(), nil
+}
+
+// SetMemberRole 设置成员角色，设置为群主时原群主变为管理员
+func (s *CustomGroupServiceServer) SetMemberRole(ctx context.Context, req *SetMemberRoleRequest) (*GroupResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return failed("你不是用户本人"), nil
+	}
+	if req.Target == req.Username {
+		return failed("不能修改自己的角色"), nil
+	}
+	role, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if role != roleOwner {
+		return failed("只有群主可以设置成员角色"), nil
+	}
+	targetRole, err := GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Target)
+	if err != nil {
+		return failed(err.Error()), nil
+	}
+	if targetRole == "" {
+		return failed("对方不是群成员"), nil
+	}
+
+	newRole := fromGroupRole(req.Role)
+	if newRole == roleOwner {
+		// 转让群主，三处修改在同一个事务中完成
+		if err := transferOwner(ctx, s.mongoClient, req.GroupId, req.Username, req.Target); err != nil {
+			return failed(err.Error()), nil
+		}
+		s.notifyMembers(ctx, req.GroupId, eventRoleChanged, req.Username, req.Username, roleAdmin)
+	} else if _, err := s.mongoClient.DB.Collection("group_members").UpdateOne(ctx,
+		bson.M{"group_id": req.GroupId, "username": req.Target, "role": bson.M{"$ne": roleOwner}},
+		bson.M{"$set": bson.M{"role": newRole}},
+	); err != nil {
+		return failed(err.Error()), nil
+	}
+	s.notifyMembers(ctx, req.GroupId, eventRoleChanged, req.Username, req.Target, newRole)
+	return succeeded(), nil
+}
+
+// GetGroupMembers 获取群成员列表，仅群成员可以查询
+func (s *CustomGroupServiceServer) GetGroupMembers(ctx context.Context, req *GetGroupMembersRequest) (*GetGroupMembersResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetGroupMembersResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	group, err := findGroup(ctx, s.mongoClient, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return &GetGroupMembersResponse{
+			Success:  false,
+			ErrorMsg: "群组不存在",
+		}, nil
+	}
+	members, err := listMembers(ctx, s.mongoClient, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := false
+	items := make([]*GroupMember, 0, len(members))
+	for _, member := range members {
+		if member.Username == req.Username {
+			isMember = true
+		}
+		items = append(items, &GroupMember{
+			Username: member.Username,
+			Role:     toGroupRole(member.Role),
+			JoinedAt: member.JoinedAt.Format(time.RFC3339),
+		})
+	}
+	if !isMember {
+		return &GetGroupMembersResponse{
+			Success:  false,
+			ErrorMsg: "你不是群成员",
+		}, nil
+	}
+
+	return &GetGroupMembersResponse{
+		Success: true,
+		Name:    group.Name,
+		Members: items,
+	}, nil
+}
+
+// GetUserGroups 获取用户加入的所有群组
+func (s *CustomGroupServiceServer) GetUserGroups(ctx context.Context, req *GetUserGroupsRequest) (*GetUserGroupsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetUserGroupsResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	cursor, err := s.mongoClient.DB.Collection("group_members").Find(ctx, bson.M{"username": req.Username})
+	if err != nil {
+		return nil, err
+	}
+	var memberships []memberDocument
+	if err := cursor.All(ctx, &memberships); err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return &GetUserGroupsResponse{Success: true}, nil
+	}
+
+	roles := make(map[string]string, len(memberships))
+	ids := make([]primitive.ObjectID, 0, len(memberships))
+	for _, membership := range memberships {
+		id, err := primitive.ObjectIDFromHex(membership.GroupID)
+		if err != nil {
+			continue
+		}
+		roles[membership.GroupID] = membership.Role
+		ids = append(ids, id)
+	}
+	cursor, err = s.mongoClient.DB.Collection("groups").Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	var groups []groupDocument
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	infos := make([]*GroupInfo, 0, len(groups))
+	for _, group := range groups {
+		infos = append(infos, &GroupInfo{
+			GroupId: group.ID.Hex(),
+			Name:    group.Name,
+			Owner:   group.Owner,
+			Public:  group.Public,
+			Role:    toGroupRole(roles[group.ID.Hex()]),
+		})
+	}
+	return &GetUserGroupsResponse{
+		Success: true,
+		Groups:  infos,
+	}, nil
+}
+
+// notifyMembers 向群组的所有成员推送群组事件，target 不在群中时同样会收到
+func (s *CustomGroupServiceServer) notifyMembers(ctx context.Context, groupID, eventType, actor, target, role string) {
+	members, err := ListMemberNames(ctx, s.mongoClient, groupID)
+	if err != nil {
+		log.Printf("查询群组 %s 的成员失败: %v", groupID, err)
+		return
+	}
+	if target != "" {
+		members = append(members, target)
+	}
+	if err := s.kafkaProducer.SendGroupEvent(groupID, eventType, actor, target, role, members); err != nil {
+		log.Printf("发送群组事件到 Kafka 失败: %v", err)
+	}
+}
+
+// succeeded 返回成功响应
+func succeeded() *GroupResponse {
+	return &GroupResponse{Success: true}
+}
+
+// failed 返回失败响应
+func failed(errMsg string) *GroupResponse {
+	return &GroupResponse{
+		Success:  false,
+		ErrorMsg: errMsg,
+	}
+}
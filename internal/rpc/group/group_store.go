@@ -0,0 +1,292 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"time"
+)
+
+// 群成员角色，存储在 group_members 集合中
+const (
+	roleOwner  = "owner"
+	roleAdmin  = "admin"
+	roleMember = "member"
+)
+
+// maxGroupMembers 群成员上限
+const maxGroupMembers = 500
+
+// groupDocument groups 集合中的群组文档
+type groupDocument struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	Name      string             `bson:"name"`
+	Owner     string             `bson:"owner"`
+	Public    bool               `bson:"public"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// memberDocument group_members 集合中的成员文档
+type memberDocument struct {
+	GroupID  string    `bson:"group_id"`
+	Username string    `bson:"username"`
+	Role     string    `bson:"role"`
+	JoinedAt time.Time `bson:"joined_at"`
+}
+
+var (
+	// errGroupFull 群成员数量已达上限
+	errGroupFull = errors.New("群成员数量超过上限")
+	// errNotOwner 操作者已不是群主
+	errNotOwner = errors.New("只有群主可以设置成员角色")
+	// errTargetNotMember 目标用户不是群成员
+	errTargetNotMember = errors.New("对方不是群成员")
+)
+
+// EnsureIndexes 创建群组相关集合的索引
+func EnsureIndexes(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	_, err := mongoClient.DB.Collection("group_members").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// 每个用户在一个群中只有一条成员记录，并发加入时 addMember 的 upsert 不会插入重复成员
+		{
+			Keys:    bson.D{{Key: "group_id", Value: 1}, {Key: "username", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		// 查询用户加入的群组
+		{Keys: bson.D{{Key: "username", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("group_invites").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "group_id", Value: 1}, {Key: "username", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "username", Value: 1}}},
+	})
+	return err
+}
+
+// withTransaction 在事务中执行 fn，写冲突等临时错误由驱动自动重试
+func withTransaction(ctx context.Context, mongoClient *mongodb.MongoClient, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := mongoClient.Client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+// toGroupRole 将存储的角色转换为接口中的枚举
+func toGroupRole(role string) GroupRole {
+	switch role {
+	case roleOwner:
+		return GroupRole_OWNER
+	case roleAdmin:
+		return GroupRole_ADMIN
+	default:
+		return GroupRole_MEMBER
+	}
+}
+
+// fromGroupRole 将接口中的角色转换为存储的角色
+func fromGroupRole(role GroupRole) string {
+	switch role {
+	case GroupRole_OWNER:
+		return roleOwner
+	case GroupRole_ADMIN:
+		return roleAdmin
+	default:
+		return roleMember
+	}
+}
+
+// findGroup 查询群组，不存在时返回 nil
+func findGroup(ctx context.Context, mongoClient *mongodb.MongoClient, groupID string) (*groupDocument, error) {
+	id, err := primitive.ObjectIDFromHex(groupID)
+	if err != nil {
+		return nil, nil
+	}
+	var group groupDocument
+	err = mongoClient.DB.Collection("groups").FindOne(ctx, bson.M{"_id": id}).Decode(&group)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetMemberRole 返回用户在群中的角色，不是群成员时返回空字符串
+func GetMemberRole(ctx context.Context, mongoClient *mongodb.MongoClient, groupID, username string) (string, error) {
+	var member memberDocument
+	err := mongoClient.DB.Collection("group_members").FindOne(ctx, bson.M{
+		"group_id": groupID,
+		"username": username,
+	}).Decode(&member)
+	if err == mongo.ErrNoDocuments {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// ListMemberNames 返回群组的所有成员
+func ListMemberNames(ctx context.Context, mongoClient *mongodb.MongoClient, groupID string) ([]string, error) {
+	members, err := listMembers(ctx, mongoClient, groupID)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		names = append(names, member.Username)
+	}
+	return names, nil
+}
+
+// ListUserGroupIDs 返回用户加入的所有群组的 ID
+func ListUserGroupIDs(ctx context.Context, mongoClient *mongodb.MongoClient, username string) ([]string, error) {
+	cursor, err := mongoClient.DB.Collection("group_members").Find(ctx, bson.M{"username": username})
+	if err != nil {
+		return nil, err
+	}
+	var memberships []memberDocument
+	if err := cursor.All(ctx, &memberships); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		ids = append(ids, membership.GroupID)
+	}
+	return ids, nil
+}
+
+// listMembers 按加入时间返回群组的所有成员
+func listMembers(ctx context.Context, mongoClient *mongodb.MongoClient, groupID string) ([]memberDocument, error) {
+	cursor, err := mongoClient.DB.Collection("group_members").Find(ctx, bson.M{"group_id": groupID})
+	if err != nil {
+		return nil, err
+	}
+	var members []memberDocument
+	if err := cursor.All(ctx, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// lockGroup 在事务中写入群组文档，并发修改同一群组的事务会发生写冲突并由驱动重试，
+// 使成员数检查和角色转让按顺序执行
+func lockGroup(sessCtx mongo.SessionContext, mongoClient *mongodb.MongoClient, groupID string) error {
+	id, err := primitive.ObjectIDFromHex(groupID)
+	if err != nil {
+		return err
+	}
+	_, err = mongoClient.DB.Collection("groups").UpdateOne(sessCtx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{"members_updated_at": time.Now()}},
+	)
+	return err
+}
+
+// addMemberLimited 在事务中检查成员数后添加群成员，群已满时返回 errGroupFull，返回是否新加入
+func addMemberLimited(ctx context.Context, mongoClient *mongodb.MongoClient, groupID, username, role string) (bool, error) {
+	var joined bool
+	err := withTransaction(ctx, mongoClient, func(sessCtx mongo.SessionContext) error {
+		joined = false
+		if err := lockGroup(sessCtx, mongoClient, groupID); err != nil {
+			return err
+		}
+		existing, err := GetMemberRole(sessCtx, mongoClient, groupID, username)
+		if err != nil {
+			return err
+		}
+		if existing != "" {
+			return nil
+		}
+		count, err := countMembers(sessCtx, mongoClient, groupID)
+		if err != nil {
+			return err
+		}
+		if count >= maxGroupMembers {
+			return errGroupFull
+		}
+		joined, err = addMember(sessCtx, mongoClient, groupID, username, role)
+		return err
+	})
+	return joined, err
+}
+
+// transferOwner 在事务中将群主转让给 target，原群主变为管理员
+// owner 已不是群主时返回 errNotOwner
+func transferOwner(ctx context.Context, mongoClient *mongodb.MongoClient, groupID, owner, target string) error {
+	id, err := primitive.ObjectIDFromHex(groupID)
+	if err != nil {
+		return err
+	}
+	return withTransaction(ctx, mongoClient, func(sessCtx mongo.SessionContext) error {
+		// 以群组文档中的群主为准，同时转让的请求只有一个成功
+		result, err := mongoClient.DB.Collection("groups").UpdateOne(sessCtx,
+			bson.M{"_id": id, "owner": owner},
+			bson.M{"$set": bson.M{"owner": target}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errNotOwner
+		}
+		members := mongoClient.DB.Collection("group_members")
+		result, err = members.UpdateOne(sessCtx,
+			bson.M{"group_id": groupID, "username": target},
+			bson.M{"$set": bson.M{"role": roleOwner}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return errTargetNotMember
+		}
+		_, err = members.UpdateOne(sessCtx,
+			bson.M{"group_id": groupID, "username": owner},
+			bson.M{"$set": bson.M{"role": roleAdmin}},
+		)
+		return err
+	})
+}
+
+// addMember 添加群成员，已是成员时不做修改，返回是否新加入
+func addMember(ctx context.Context, mongoClient *mongodb.MongoClient, groupID, username, role string) (bool, error) {
+	result, err := mongoClient.DB.Collection("group_members").UpdateOne(ctx,
+		bson.M{"group_id": groupID, "username": username},
+		bson.M{"$setOnInsert": bson.M{"role": role, "joined_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+// removeMember 移除群成员
+func removeMember(ctx context.Context, mongoClient *mongodb.MongoClient, groupID, username string) error {
+	_, err := mongoClient.DB.Collection("group_members").DeleteOne(ctx, bson.M{
+		"group_id": groupID,
+		"username": username,
+	})
+	return err
+}
+
+// countMembers 返回群成员数
+func countMembers(ctx context.Context, mongoClient *mongodb.MongoClient, groupID string) (int64, error) {
+	return mongoClient.DB.Collection("group_members").CountDocuments(ctx, bson.M{"group_id": groupID})
+}
@@ -0,0 +1,267 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"im-service/internal/data/kafka"
+	"im-service/internal/data/model"
+	"im-service/internal/rpc/group"
+	"log"
+	"time"
+)
+
+// errMessageNotFound 消息不存在
+var errMessageNotFound = errors.New("消息不存在")
+
+// findMessage 按对外的消息 ID 查询消息
+func (s *CustomMessageServiceServer) findMessage(ctx context.Context, messageID string) (*messageDocument, error) {
+	id, err := parseMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+	var doc messageDocument
+	err = s.mongoClient.DB.Collection("messages").FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errMessageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 过期的消息在清理之前也视为不存在
+	if s.expired(doc.Timestamp, doc.ExpireAt, time.Now()) {
+		return nil, errMessageNotFound
+	}
+	return &doc, nil
+}
+
+// messageTargets 返回消息变更需要推送的用户：单聊为双方，群聊为所有成员
+func (s *CustomMessageServiceServer) messageTargets(ctx context.Context, doc *messageDocument) ([]string, error) {
+	if doc.GroupID != "" {
+		return group.ListMemberNames(ctx, s.mongoClient, doc.GroupID)
+	}
+	return []string{doc.To, doc.From}, nil
+}
+
+// notifyMessageChange 将消息变更推送给相关用户，推送失败只记录日志
+func (s *CustomMessageServiceServer) notifyMessageChange(eventType, operator string, doc *messageDocument, content string, targets []string) {
+	err := s.kafkaProducer.SendMessageChange(eventType, operator, formatMessageID(doc.ID), doc.conversationID(), content, targets)
+	if err != nil {
+		log.Printf("发送消息变更事件到 Kafka 失败: %v", err)
+	}
+}
+
+// RecallMessage 发送者在撤回时限内撤回消息，清空内容、附件引用和编辑历史
+func (s *CustomMessageServiceServer) RecallMessage(ctx context.Context, req *RecallMessageRequest) (*RecallMessageResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &RecallMessageResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := s.findMessage(ctx, req.MessageId)
+	if err != nil {
+		return &RecallMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if doc.From != req.Username {
+		return &RecallMessageResponse{
+			Success:  false,
+			ErrorMsg: "只能撤回自己发送的消息",
+		}, nil
+	}
+	if doc.Recalled {
+		return &RecallMessageResponse{Success: true}, nil
+	}
+	if time.Since(doc.Timestamp) > s.recallWindow {
+		return &RecallMessageResponse{
+			Success:  false,
+			ErrorMsg: "已超过撤回时限",
+		}, nil
+	}
+
+	result, err := s.mongoClient.DB.Collection("messages").UpdateOne(ctx,
+		bson.M{"_id": doc.ID, "recalled": bson.M{"$ne": true}},
+		bson.M{
+			"$set":   bson.M{"recalled": true, "recalled_at": time.Now(), "content": ""},
+			"$unset": bson.M{"edits": "", "attachments": "", "location": "", "search_text": "", "encrypted_payloads": ""},
+		},
+	)
+	if err != nil {
+		return &RecallMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if result.ModifiedCount == 0 {
+		// 并发撤回，由另一个请求推送
+		return &RecallMessageResponse{Success: true}, nil
+	}
+
+	doc.Recalled = true
+	doc.Content = ""
+	s.updateConversationPreview(ctx, doc)
+
+	// 接收者尚未收到的消息不再补推，上线后通过同步接口得知消息已撤回
+	if doc.To != "" {
+		if err := s.redisClient.RemoveInbox(ctx, doc.To, req.MessageId); err != nil {
+			log.Printf("从用户 %s 的离线收件箱移除消息失败: %v", doc.To, err)
+		}
+	}
+	targets, err := s.messageTargets(ctx, doc)
+	if err != nil {
+		log.Printf("查询消息 %s 的推送对象失败: %v", req.MessageId, err)
+	} else {
+		s.notifyMessageChange(kafka.EventRecall, req.Username, doc, "", targets)
+	}
+
+	return &RecallMessageResponse{Success: true}, nil
+}
+
+// EditMessage 发送者编辑消息，被替换的内容追加到编辑历史中
+func (s *CustomMessageServiceServer) EditMessage(ctx context.Context, req *EditMessageRequest) (*EditMessageResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.Content == "" {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "消息内容不能为空",
+		}, nil
+	}
+
+	doc, err := s.findMessage(ctx, req.MessageId)
+	if err != nil {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if doc.From != req.Username {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "只能编辑自己发送的消息",
+		}, nil
+	}
+	if doc.Recalled {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "消息已撤回",
+		}, nil
+	}
+	if doc.Type == model.MessageEncrypted {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "加密消息不支持编辑",
+		}, nil
+	}
+
+	// 以读取时的内容作为条件，避免并发编辑丢失历史
+	now := time.Now()
+	result, err := s.mongoClient.DB.Collection("messages").UpdateOne(ctx,
+		bson.M{"_id": doc.ID, "content": doc.Content, "recalled": bson.M{"$ne": true}},
+		bson.M{
+			"$set":  bson.M{"content": req.Content, "search_text": searchText(req.Content), "edited_at": now},
+			"$push": bson.M{"edits": messageEdit{Content: doc.Content, EditedAt: now}},
+		},
+	)
+	if err != nil {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if result.MatchedCount == 0 {
+		return &EditMessageResponse{
+			Success:  false,
+			ErrorMsg: "消息已被修改，请重试",
+		}, nil
+	}
+
+	doc.Content = req.Content
+	s.updateConversationPreview(ctx, doc)
+
+	if doc.To != "" {
+		if err := s.redisClient.UpdateInboxContent(ctx, doc.To, req.MessageId, req.Content); err != nil {
+			log.Printf("更新用户 %s 离线收件箱中的消息失败: %v", doc.To, err)
+		}
+	}
+	targets, err := s.messageTargets(ctx, doc)
+	if err != nil {
+		log.Printf("查询消息 %s 的推送对象失败: %v", req.MessageId, err)
+	} else {
+		s.notifyMessageChange(kafka.EventEdit, req.Username, doc, req.Content, targets)
+	}
+
+	return &EditMessageResponse{
+		Success:  true,
+		EditedAt: now.Format(time.RFC3339),
+	}, nil
+}
+
+// DeleteMessage 为用户自己删除消息，消息对会话中的其他人仍然可见
+func (s *CustomMessageServiceServer) DeleteMessage(ctx context.Context, req *DeleteMessageRequest) (*DeleteMessageResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &DeleteMessageResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	doc, err := s.findMessage(ctx, req.MessageId)
+	if err != nil {
+		return &DeleteMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err := s.checkConversationMember(ctx, doc.conversationID(), req.Username); err != nil {
+		// 不暴露消息是否存在
+		return &DeleteMessageResponse{
+			Success:  false,
+			ErrorMsg: errMessageNotFound.Error(),
+		}, nil
+	}
+
+	_, err = s.mongoClient.DB.Collection("messages").UpdateOne(ctx,
+		bson.M{"_id": doc.ID},
+		bson.M{"$addToSet": bson.M{"deleted_for": req.Username}},
+	)
+	if err != nil {
+		return &DeleteMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err := s.redisClient.RemoveInbox(ctx, req.Username, req.MessageId); err != nil {
+		log.Printf("从用户 %s 的离线收件箱移除消息失败: %v", req.Username, err)
+	}
+	s.replaceDeletedConversationMessage(ctx, doc, req.Username)
+	if doc.From != req.Username {
+		// 删除的消息不再计入未读
+		if _, err := s.refreshUnread(ctx, req.Username, doc.conversationID()); err != nil {
+			log.Printf("更新用户 %s 的未读数失败: %v", req.Username, err)
+		}
+	}
+
+	// 删除只影响自己，推送给自己的其他设备
+	s.notifyMessageChange(kafka.EventDelete, req.Username, doc, "", []string{req.Username})
+
+	return &DeleteMessageResponse{Success: true}, nil
+}
@@ -0,0 +1,472 @@
+package message
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/model"
+	"im-service/internal/data/mongodb"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	// conversationPreviewLength 会话列表中最后一条消息预览的最大字符数
+	conversationPreviewLength = 50
+	// defaultConversationLimit 查询会话列表的默认条数
+	defaultConversationLimit = 50
+	// maxConversationLimit 查询会话列表的最大条数
+	maxConversationLimit = 200
+)
+
+// conversationMessage 会话索引中记录的最后一条消息
+type conversationMessage struct {
+	MessageID string     `bson:"message_id"`
+	Seq       int64      `bson:"seq"`
+	From      string     `bson:"from"`
+	Type      string     `bson:"type,omitempty"`
+	Content   string     `bson:"content"` // 截断后的预览
+	Timestamp time.Time  `bson:"timestamp"`
+	Recalled  bool       `bson:"recalled,omitempty"`
+	ExpireAt  *time.Time `bson:"expire_at,omitempty"`
+}
+
+// conversationDocument conversations 集合中的文档，每个用户的每个会话一条
+type conversationDocument struct {
+	Owner          string               `bson:"owner"`
+	ConversationID string               `bson:"conversation_id"`
+	Peer           string               `bson:"peer,omitempty"`
+	GroupID        string               `bson:"group_id,omitempty"`
+	LastMessage    *conversationMessage `bson:"last_message,omitempty"`
+	LastActivity   time.Time            `bson:"last_activity"`
+	UnreadCount    int64                `bson:"unread_count"`
+	ReadSeq        *int64               `bson:"read_seq,omitempty"` // 群会话中已读到的序列号，从未标记已读时为空
+	Pinned         bool                 `bson:"pinned"`
+	Muted          bool                 `bson:"muted"`
+	Archived       bool                 `bson:"archived"`
+	// DisappearingSeconds 会话的阅后即焚时长，只用于展示，以 conversation_settings 为准
+	DisappearingSeconds int64 `bson:"disappearing_seconds,omitempty"`
+	// Encrypted 会话已启用端到端加密，服务端不再保存明文
+	Encrypted bool `bson:"encrypted,omitempty"`
+}
+
+// toConversation 转换为接口中的会话
+func (d *conversationDocument) toConversation() *Conversation {
+	conversation := &Conversation{
+		ConversationId:      d.ConversationID,
+		Peer:                d.Peer,
+		GroupId:             d.GroupID,
+		UnreadCount:         d.UnreadCount,
+		LastActivity:        d.LastActivity.Format(time.RFC3339),
+		Pinned:              d.Pinned,
+		Muted:               d.Muted,
+		Archived:            d.Archived,
+		DisappearingSeconds: d.DisappearingSeconds,
+		Encrypted:           d.Encrypted,
+	}
+	if m := d.LastMessage; m != nil {
+		conversation.LastMessage = &MessageItem{
+			MessageId:      m.MessageID,
+			From:           m.From,
+			GroupId:        d.GroupID,
+			Type:           toMessageType(m.Type),
+			Content:        m.Content,
+			Timestamp:      m.Timestamp.Format(time.RFC3339),
+			ConversationId: d.ConversationID,
+			Seq:            m.Seq,
+			Recalled:       m.Recalled,
+			ExpireAt:       formatTime(m.ExpireAt),
+		}
+		if d.Peer != "" {
+			// 单聊中最后一条消息的接收者是发送者的对方
+			conversation.LastMessage.To = d.Owner
+			if m.From == d.Owner {
+				conversation.LastMessage.To = d.Peer
+			}
+		}
+	}
+	return conversation
+}
+
+// previewContent 截断消息内容作为会话列表中的预览
+func previewContent(content string) string {
+	runes := []rune(content)
+	if len(runes) <= conversationPreviewLength {
+		return content
+	}
+	return string(runes[:conversationPreviewLength])
+}
+
+// newConversationMessage 根据消息文档生成会话索引中的最后一条消息
+func newConversationMessage(doc *messageDocument) *conversationMessage {
+	return &conversationMessage{
+		MessageID: formatMessageID(doc.ID),
+		Seq:       doc.Seq,
+		From:      doc.From,
+		Type:      doc.Type,
+		Content:   previewContent(doc.Content),
+		Timestamp: doc.Timestamp,
+		Recalled:  doc.Recalled,
+		ExpireAt:  doc.ExpireAt,
+	}
+}
+
+// conversationMessagesFilter 返回会话中所有消息的查询条件，早期消息没有 conversation_id，按双方或群组查询
+func conversationMessagesFilter(conversationID string) bson.M {
+	if strings.HasPrefix(conversationID, groupConversationPrefix) {
+		return bson.M{"group_id": strings.TrimPrefix(conversationID, groupConversationPrefix)}
+	}
+	pair := strings.SplitN(strings.TrimPrefix(conversationID, p2pConversationPrefix), ":", 2)
+	if len(pair) != 2 {
+		return bson.M{"conversation_id": conversationID}
+	}
+	return bson.M{"$or": []bson.M{
+		{"conversation_id": conversationID},
+		{"participants": participants(pair[0], pair[1]), "conversation_id": bson.M{"$exists": false}},
+	}}
+}
+
+// touchConversations 新消息写入后更新相关用户的会话索引，发送者以外的用户未读数加一
+// 并发写入时以序列号较大的消息作为最后一条消息；更新失败只记录日志，不影响消息发送
+func (s *CustomMessageServiceServer) touchConversations(ctx context.Context, doc *messageDocument, owners []string) {
+	conversationID := doc.conversationID()
+	last := newConversationMessage(doc)
+	models := make([]mongo.WriteModel, 0, len(owners))
+	for _, owner := range owners {
+		increment := 1
+		if owner == doc.From {
+			increment = 0
+		}
+		// 使用管道更新以便比较序列号，用户提供的值以 $literal 包裹，避免以 $ 开头时被当作字段路径
+		fields := bson.M{
+			"owner":           bson.M{"$literal": owner},
+			"conversation_id": bson.M{"$literal": conversationID},
+			"unread_count":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$unread_count", 0}}, increment}},
+			"last_message": bson.M{"$cond": bson.A{
+				bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$last_message.seq", 0}}, last.Seq}},
+				bson.M{"$literal": last},
+				"$last_message",
+			}},
+			"last_activity": bson.M{"$max": bson.A{"$last_activity", last.Timestamp}},
+			"pinned":        bson.M{"$ifNull": bson.A{"$pinned", false}},
+			"muted":         bson.M{"$ifNull": bson.A{"$muted", false}},
+			"archived":      bson.M{"$ifNull": bson.A{"$archived", false}},
+		}
+		var disappearingSeconds int64
+		if doc.ExpireAt != nil {
+			disappearingSeconds = int64(doc.ExpireAt.Sub(doc.Timestamp) / time.Second)
+		}
+		fields["disappearing_seconds"] = disappearingSeconds
+		// 会话发送过加密消息后一直保持加密状态
+		fields["encrypted"] = bson.M{"$or": bson.A{
+			bson.M{"$ifNull": bson.A{"$encrypted", false}},
+			doc.Type == model.MessageEncrypted,
+		}}
+		if doc.GroupID != "" {
+			fields["group_id"] = bson.M{"$literal": doc.GroupID}
+		} else if peer, ok := conversationPeer(conversationID, owner); ok {
+			fields["peer"] = bson.M{"$literal": peer}
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"owner": owner, "conversation_id": conversationID}).
+			SetUpdate(mongo.Pipeline{{{Key: "$set", Value: fields}}}).
+			SetUpsert(true))
+	}
+	_, err := s.mongoClient.DB.Collection("conversations").BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		log.Printf("更新会话 %s 的会话索引失败: %v", conversationID, err)
+	}
+	s.incrementUnread(ctx, conversationID, doc.From, owners)
+}
+
+// updateConversationPreview 消息被撤回或编辑后，更新以其为最后一条消息的会话索引
+func (s *CustomMessageServiceServer) updateConversationPreview(ctx context.Context, doc *messageDocument) {
+	conversationID := doc.conversationID()
+	_, err := s.mongoClient.DB.Collection("conversations").UpdateMany(ctx,
+		bson.M{"conversation_id": conversationID, "last_message.message_id": formatMessageID(doc.ID)},
+		bson.M{"$set": bson.M{
+			"last_message.content":  previewContent(doc.Content),
+			"last_message.recalled": doc.Recalled,
+		}},
+	)
+	if err != nil {
+		log.Printf("更新会话 %s 的最后一条消息失败: %v", conversationID, err)
+	}
+}
+
+// replaceDeletedConversationMessage 用户为自己删除了会话的最后一条消息后，以其仍可见的最新消息代替
+func (s *CustomMessageServiceServer) replaceDeletedConversationMessage(ctx context.Context, doc *messageDocument, username string) {
+	conversationID := doc.conversationID()
+	filter := bson.M{
+		"owner":                   username,
+		"conversation_id":         conversationID,
+		"last_message.message_id": formatMessageID(doc.ID),
+	}
+	var latest messageDocument
+	err := s.mongoClient.DB.Collection("messages").FindOne(ctx,
+		bson.M{"$and": []bson.M{
+			conversationMessagesFilter(conversationID),
+			s.unexpiredFilter(time.Now()),
+			{"deleted_for": bson.M{"$ne": username}},
+		}},
+		options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}),
+	).Decode(&latest)
+	var update bson.M
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		update = bson.M{"$unset": bson.M{"last_message": ""}}
+	case err != nil:
+		log.Printf("查询会话 %s 的最新消息失败: %v", conversationID, err)
+		return
+	default:
+		update = bson.M{"$set": bson.M{"last_message": newConversationMessage(&latest)}}
+	}
+	if _, err := s.mongoClient.DB.Collection("conversations").UpdateOne(ctx, filter, update); err != nil {
+		log.Printf("更新会话 %s 的最后一条消息失败: %v", conversationID, err)
+	}
+}
+
+// conversationCursor 游标指向的会话位置，会话按 (pinned, last_activity, conversation_id) 倒序排列
+type conversationCursor struct {
+	Pinned         bool   `json:"p"`
+	LastActivity   int64  `json:"t"`
+	ConversationID string `json:"id"`
+}
+
+// encodeConversationCursor 将会话位置编码为不透明的游标
+func encodeConversationCursor(doc *conversationDocument) string {
+	data, _ := json.Marshal(&conversationCursor{
+		Pinned:         doc.Pinned,
+		LastActivity:   doc.LastActivity.UnixMilli(),
+		ConversationID: doc.ConversationID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// conversationCursorFilter 返回排在游标之后的会话的查询条件
+func conversationCursorFilter(cursor string) (bson.M, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	var c conversationCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, errInvalidCursor
+	}
+	lastActivity := time.UnixMilli(c.LastActivity)
+	conditions := []bson.M{
+		{"pinned": c.Pinned, "last_activity": bson.M{"$lt": lastActivity}},
+		{"pinned": c.Pinned, "last_activity": lastActivity, "conversation_id": bson.M{"$lt": c.ConversationID}},
+	}
+	if c.Pinned {
+		conditions = append(conditions, bson.M{"pinned": false})
+	}
+	return bson.M{"$or": conditions}, nil
+}
+
+// GetConversations 获取用户的会话列表，置顶的会话在前，其余按最后活动时间倒序
+func (s *CustomMessageServiceServer) GetConversations(ctx context.Context, req *GetConversationsRequest) (*GetConversationsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetConversationsResponse{
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultConversationLimit
+	}
+	if limit > maxConversationLimit {
+		limit = maxConversationLimit
+	}
+
+	conditions := []bson.M{{"owner": req.Username, "archived": req.Archived}}
+	if req.Before != "" {
+		condition, err := conversationCursorFilter(req.Before)
+		if err != nil {
+			return &GetConversationsResponse{
+				ErrorMsg: err.Error(),
+			}, nil
+		}
+		conditions = append(conditions, condition)
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "pinned", Value: -1}, {Key: "last_activity", Value: -1}, {Key: "conversation_id", Value: -1}}).
+		SetLimit(int64(limit) + 1)
+	cursor, err := s.mongoClient.DB.Collection("conversations").Find(ctx, bson.M{"$and": conditions}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []conversationDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	hasMore := len(docs) > int(limit)
+	if hasMore {
+		docs = docs[:limit]
+		nextCursor = encodeConversationCursor(&docs[len(docs)-1])
+	}
+	now := time.Now()
+	conversations := make([]*Conversation, 0, len(docs))
+	for i := range docs {
+		// 最后一条消息过期后在清理之前也不再展示
+		if m := docs[i].LastMessage; m != nil && s.expired(m.Timestamp, m.ExpireAt, now) {
+			docs[i].LastMessage = nil
+		}
+		conversations = append(conversations, docs[i].toConversation())
+	}
+
+	return &GetConversationsResponse{
+		Conversations: conversations,
+		NextCursor:    nextCursor,
+		HasMore:       hasMore,
+	}, nil
+}
+
+// UpdateConversation 修改会话的置顶、免打扰和归档状态
+func (s *CustomMessageServiceServer) UpdateConversation(ctx context.Context, req *UpdateConversationRequest) (*UpdateConversationResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &UpdateConversationResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	fields := bson.M{}
+	if req.Pinned != nil {
+		fields["pinned"] = *req.Pinned
+	}
+	if req.Muted != nil {
+		fields["muted"] = *req.Muted
+	}
+	if req.Archived != nil {
+		fields["archived"] = *req.Archived
+	}
+	if len(fields) == 0 {
+		return &UpdateConversationResponse{
+			Success:  false,
+			ErrorMsg: "没有需要修改的内容",
+		}, nil
+	}
+
+	result, err := s.mongoClient.DB.Collection("conversations").UpdateOne(ctx,
+		bson.M{"owner": req.Username, "conversation_id": req.ConversationId},
+		bson.M{"$set": fields},
+	)
+	if err != nil {
+		return &UpdateConversationResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if result.MatchedCount == 0 {
+		return &UpdateConversationResponse{
+			Success:  false,
+			ErrorMsg: "会话不存在",
+		}, nil
+	}
+
+	return &UpdateConversationResponse{Success: true}, nil
+}
+
+// BackfillConversations 会话索引为空时，根据已有消息为每个用户生成会话索引
+// 单聊的未读数按消息的已读状态统计，群聊没有已读状态，未读数为 0；已存在的会话不会被覆盖
+func BackfillConversations(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	count, err := mongoClient.DB.Collection("conversations").CountDocuments(ctx, bson.M{}, options.Count().SetLimit(1))
+	if err != nil || count > 0 {
+		return err
+	}
+
+	first := bson.M{"$arrayElemAt": bson.A{"$participants", 0}}
+	second := bson.M{"$arrayElemAt": bson.A{"$participants", 1}}
+	unreadFor := func(user bson.M) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{
+			bson.M{"$and": bson.A{bson.M{"$eq": bson.A{"$to", user}}, bson.M{"$ne": bson.A{"$status", statusRead}}}},
+			1, 0,
+		}}}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$or": []bson.M{
+			{"group_id": bson.M{"$exists": true}},
+			{"participants": bson.M{"$exists": true}},
+		}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"$ifNull": bson.A{
+				bson.M{"$concat": bson.A{groupConversationPrefix, "$group_id"}},
+				bson.M{"$concat": bson.A{p2pConversationPrefix, first, ":", second}},
+			}},
+			"group_id":     bson.M{"$first": "$group_id"},
+			"participants": bson.M{"$first": "$participants"},
+			"last_message": bson.M{"$first": bson.M{
+				"message_id": bson.M{"$toString": "$_id"},
+				"seq":        bson.M{"$ifNull": bson.A{"$seq", 0}},
+				"from":       "$from",
+				"type":       "$type",
+				"content":    bson.M{"$substrCP": bson.A{"$content", 0, conversationPreviewLength}},
+				"timestamp":  "$timestamp",
+				"recalled":   "$recalled",
+			}},
+			"unread_first":  unreadFor(first),
+			"unread_second": unreadFor(second),
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "group_members",
+			"localField":   "group_id",
+			"foreignField": "group_id",
+			"as":           "members",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"owners": bson.M{"$cond": bson.A{
+				bson.M{"$ifNull": bson.A{"$group_id", false}},
+				bson.M{"$map": bson.M{"input": "$members", "as": "m", "in": bson.M{"owner": "$$m.username", "unread_count": 0}}},
+				bson.A{
+					bson.M{"owner": first, "peer": second, "unread_count": "$unread_first"},
+					bson.M{"owner": second, "peer": first, "unread_count": "$unread_second"},
+				},
+			}},
+			"group_id":     1,
+			"last_message": 1,
+		}}},
+		{{Key: "$unwind", Value: "$owners"}},
+		{{Key: "$project", Value: bson.M{
+			"_id":             0,
+			"owner":           "$owners.owner",
+			"conversation_id": "$_id",
+			"peer":            "$owners.peer",
+			"group_id":        1,
+			"last_message":    1,
+			"last_activity":   "$last_message.timestamp",
+			"unread_count":    "$owners.unread_count",
+			"pinned":          bson.M{"$literal": false},
+			"muted":           bson.M{"$literal": false},
+			"archived":        bson.M{"$literal": false},
+		}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           "conversations",
+			"on":             bson.A{"owner", "conversation_id"},
+			"whenMatched":    "keepExisting",
+			"whenNotMatched": "insert",
+		}}},
+	}
+	cursor, err := mongoClient.DB.Collection("messages").Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	log.Printf("已根据历史消息生成会话索引")
+	return nil
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// errEncryptedConversation 会话启用端到端加密后发送明文消息
+var errEncryptedConversation = errors.New("会话已启用端到端加密，只能发送加密消息")
+
+// enableEncryption 会话启用端到端加密，启用后不能关闭
+func (s *CustomMessageServiceServer) enableEncryption(ctx context.Context, conversationID, username string) error {
+	_, err := s.mongoClient.DB.Collection("conversation_settings").UpdateOne(ctx,
+		bson.M{"_id": conversationID},
+		bson.M{"$set": bson.M{
+			"encrypted":    true,
+			"encrypted_by": username,
+			"encrypted_at": time.Now(),
+		}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// encryptedConversationPeers 返回与用户启用了端到端加密的单聊对象，用于在搜索等需要明文的功能中跳过这些会话
+func (s *CustomMessageServiceServer) encryptedConversationPeers(ctx context.Context, username string) ([]string, error) {
+	values, err := s.mongoClient.DB.Collection("conversations").Distinct(ctx, "peer",
+		bson.M{"owner": username, "encrypted": true, "peer": bson.M{"$exists": true}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]string, 0, len(values))
+	for _, value := range values {
+		if peer, ok := value.(string); ok {
+			peers = append(peers, peer)
+		}
+	}
+	return peers, nil
+}
@@ -0,0 +1,205 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"im-service/internal/data/kafka"
+	"im-service/internal/rpc/group"
+	"log"
+	"time"
+)
+
+// SendGroupMessage 处理发送群消息请求，消息与单聊消息存储在同一集合中，经 Kafka 推送给所有在线成员
+func (s *CustomMessageServiceServer) SendGroupMessage(ctx context.Context, req *SendGroupMessageRequest) (*SendGroupMessageResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.From {
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: "你不是发送者本人",
+		}, nil
+	}
+
+	role, err := group.GetMemberRole(ctx, s.mongoClient, req.GroupId, req.From)
+	if err != nil {
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if role == "" {
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: "你不是群成员，无法发送消息",
+		}, nil
+	}
+
+	payload, err := s.buildPayload(ctx, req.From, req.Type, req.Content, req.AttachmentIds, req.Location)
+	if err != nil {
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	id, messageID, duplicate, err := s.newMessageID(ctx, req.From, req.ClientMsgId)
+	if err != nil {
+		log.Printf("分配消息 ID 失败: %v", err)
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if duplicate {
+		return &SendGroupMessageResponse{
+			Success:   true,
+			MessageId: messageID,
+		}, nil
+	}
+
+	conversationID := groupConversationID(req.GroupId)
+	now := time.Now()
+	setting, err := s.conversationSetting(ctx, conversationID)
+	if err != nil {
+		log.Printf("查询会话 %s 的阅后即焚时长失败: %v", conversationID, err)
+		s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	expireAt := setting.expireAt(now)
+	seq, err := s.nextSeq(ctx, conversationID)
+	if err != nil {
+		log.Printf("分配会话 %s 的序列号失败: %v", conversationID, err)
+		s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 插入消息到 MongoDB，群消息以 group_id 区分，不设置 to
+	message := bson.M{
+		"_id":             id,
+		"client_msg_id":   req.ClientMsgId,
+		"from":            req.From,
+		"group_id":        req.GroupId,
+		"conversation_id": conversationID,
+		"seq":             seq,
+		"type":            payload.Type,
+		"content":         req.Content,
+		"search_text":     searchText(req.Content),
+		"timestamp":       now,
+		"status":          statusSent,
+	}
+	payload.apply(message)
+	if expireAt != nil {
+		message["expire_at"] = *expireAt
+	}
+	_, err = s.mongoClient.DB.Collection("messages").InsertOne(ctx, message)
+	if err != nil {
+		s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 群消息只推送给在线成员，离线成员上线后通过历史记录获取
+	members, err := group.ListMemberNames(ctx, s.mongoClient, req.GroupId)
+	if err != nil {
+		return &SendGroupMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	s.touchConversations(ctx, &messageDocument{
+		ID:             id,
+		From:           req.From,
+		GroupID:        req.GroupId,
+		ConversationID: conversationID,
+		Seq:            seq,
+		Type:           payload.Type,
+		Content:        req.Content,
+		Timestamp:      now,
+		ExpireAt:       expireAt,
+	}, members)
+	err = s.kafkaProducer.SendEvent(&kafka.Event{
+		Type:            kafka.EventNewMessage,
+		MessageID:       messageID,
+		ClientMsgID:     req.ClientMsgId,
+		ConversationID:  conversationID,
+		Seq:             seq,
+		From:            req.From,
+		GroupID:         req.GroupId,
+		MessageType:     payload.Type,
+		Content:         req.Content,
+		Attachments:     payload.Attachments,
+		Location:        payload.Location,
+		MessageExpireAt: expireAtMillis(expireAt),
+		Timestamp:       now.UnixMilli(),
+		Targets:         members,
+	})
+	if err != nil {
+		// 群消息已经落库，实时推送失败时由增量同步补偿，仍然返回成功
+		log.Printf("发送群消息到 Kafka 失败，消息 %s 已保存，等待同步补偿: %v", messageID, err)
+	}
+
+	return &SendGroupMessageResponse{
+		Success:   true,
+		ErrorMsg:  "",
+		MessageId: messageID,
+	}, nil
+}
+
+// GetGroupMessageHistory 处理获取群消息历史请求
+func (s *CustomMessageServiceServer) GetGroupMessageHistory(ctx context.Context, req *GetGroupMessageHistoryRequest) (*GetMessageHistoryResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetMessageHistoryResponse{
+			Messages: nil,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	role, err := group.GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		return &GetMessageHistoryResponse{
+			Messages: nil,
+			ErrorMsg: "你不是群成员",
+		}, nil
+	}
+
+	docs, nextCursor, hasMore, err := s.queryHistory(ctx,
+		bson.M{"group_id": req.GroupId, "deleted_for": bson.M{"$ne": req.Username}},
+		req.Limit, req.Before, req.After, req.Order,
+	)
+	if errors.Is(err, errInvalidCursor) {
+		return &GetMessageHistoryResponse{
+			Messages: nil,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*MessageItem, 0, len(docs))
+	for _, doc := range docs {
+		messages = append(messages, doc.toMessageItem())
+	}
+
+	return &GetMessageHistoryResponse{
+		Messages:   messages,
+		ErrorMsg:   "",
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
+	}, nil
+}
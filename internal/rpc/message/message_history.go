@@ -0,0 +1,186 @@
+package message
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/mongodb"
+	"sort"
+	"time"
+)
+
+const (
+	// defaultHistoryLimit 查询历史消息的默认条数
+	defaultHistoryLimit = 50
+	// maxHistoryLimit 查询历史消息的最大条数
+	maxHistoryLimit = 200
+)
+
+// errInvalidCursor 客户端传入的游标无法解析
+var errInvalidCursor = errors.New("无效的游标")
+
+// historyCursor 游标指向的消息位置，消息按 (timestamp, _id) 排序
+type historyCursor struct {
+	Timestamp int64  `json:"t"`
+	ID        string `json:"id"`
+}
+
+// encodeCursor 将消息位置编码为不透明的游标
+func encodeCursor(doc *messageDocument) string {
+	data, _ := json.Marshal(&historyCursor{
+		Timestamp: doc.Timestamp.UnixMilli(),
+		ID:        formatMessageID(doc.ID),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解析游标
+func decodeCursor(cursor string) (time.Time, interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, nil, errInvalidCursor
+	}
+	var c historyCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return time.Time{}, nil, errInvalidCursor
+	}
+	id, err := parseMessageID(c.ID)
+	if err != nil {
+		return time.Time{}, nil, errInvalidCursor
+	}
+	return time.UnixMilli(c.Timestamp), id, nil
+}
+
+// cursorFilter 返回位于游标之前（op 为 $lt）或之后（op 为 $gt）的消息的查询条件
+func cursorFilter(cursor, op string) (bson.M, error) {
+	timestamp, id, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return bson.M{
+		"$or": []bson.M{
+			{"timestamp": bson.M{op: timestamp}},
+			{"timestamp": timestamp, "_id": bson.M{op: id}},
+		},
+	}, nil
+}
+
+// participants 返回单聊会话双方排序后的用户名，用于索引和查询
+func participants(user1, user2 string) []string {
+	pair := []string{user1, user2}
+	sort.Strings(pair)
+	return pair
+}
+
+// queryHistory 按游标分页查询消息，多查一条用于判断是否还有更多
+func (s *CustomMessageServiceServer) queryHistory(ctx context.Context, filter bson.M, limit int32, before, after string, order SortOrder) ([]messageDocument, string, bool, error) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	conditions := []bson.M{filter, s.unexpiredFilter(time.Now())}
+	if before != "" {
+		condition, err := cursorFilter(before, "$lt")
+		if err != nil {
+			return nil, "", false, err
+		}
+		conditions = append(conditions, condition)
+	}
+	if after != "" {
+		condition, err := cursorFilter(after, "$gt")
+		if err != nil {
+			return nil, "", false, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	direction := -1
+	if order == SortOrder_ASC {
+		direction = 1
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(int64(limit) + 1)
+	cursor, err := s.mongoClient.DB.Collection("messages").Find(ctx, bson.M{"$and": conditions}, opts)
+	if err != nil {
+		return nil, "", false, err
+	}
+	var docs []messageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, "", false, err
+	}
+
+	hasMore := len(docs) > int(limit)
+	if !hasMore {
+		return docs, "", false, nil
+	}
+	docs = docs[:limit]
+	return docs, encodeCursor(&docs[len(docs)-1]), true, nil
+}
+
+// EnsureIndexes 创建消息集合和会话索引集合的索引，并为缺少 participants 的旧单聊消息补齐字段
+func EnsureIndexes(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	messagesCollection := mongoClient.DB.Collection("messages")
+
+	// participants 为排序后的 [from, to]
+	_, err := messagesCollection.UpdateMany(ctx,
+		bson.M{"participants": bson.M{"$exists": false}, "to": bson.M{"$exists": true}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"participants": bson.M{"$cond": bson.A{
+				bson.M{"$lt": bson.A{"$from", "$to"}},
+				bson.A{"$from", "$to"},
+				bson.A{"$to", "$from"},
+			}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = messagesCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "participants", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "group_id", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}},
+		// 回执查询按发送者和接收者查找最近的消息，以及处于某个投递状态的最新一条
+		{Keys: bson.D{{Key: "from", Value: 1}, {Key: "to", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "from", Value: 1}, {Key: "to", Value: 1}, {Key: "status", Value: 1}, {Key: "timestamp", Value: -1}}},
+		// 早期消息没有序列号，不参与唯一约束
+		{
+			Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "seq", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"seq": bson.M{"$exists": true}}),
+		},
+		// 清理任务按到期时间和发送时间查找过期消息
+		{
+			Keys:    bson.D{{Key: "expire_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{Keys: bson.D{{Key: "timestamp", Value: 1}}},
+		// search_text 已预先切分，不使用语言相关的词干和停用词
+		{
+			Keys:    bson.D{{Key: "search_text", Value: "text"}},
+			Options: options.Index().SetDefaultLanguage("none"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = mongoClient.DB.Collection("conversations").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "conversation_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{
+			{Key: "owner", Value: 1}, {Key: "archived", Value: 1},
+			{Key: "pinned", Value: -1}, {Key: "last_activity", Value: -1}, {Key: "conversation_id", Value: -1},
+		}},
+	})
+	return err
+}
@@ -0,0 +1,117 @@
+package message
+
+import (
+	"errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"im-service/internal/data/model"
+	"strconv"
+	"time"
+)
+
+// 消息文档中的投递状态
+const (
+	statusSent      = "sent"
+	statusDelivered = "delivered"
+	statusRead      = "read"
+)
+
+// messageDocument messages 集合中的消息文档
+type messageDocument struct {
+	ID             interface{}        `bson:"_id"` // 雪花算法 ID（int64），早期消息为 ObjectID
+	From           string             `bson:"from"`
+	To             string             `bson:"to,omitempty"`
+	GroupID        string             `bson:"group_id,omitempty"`
+	ConversationID string             `bson:"conversation_id,omitempty"`
+	Seq            int64              `bson:"seq,omitempty"`  // 会话内的序列号，早期消息为 0
+	Type           string             `bson:"type,omitempty"` // 早期消息没有类型，视为文本
+	Content        string             `bson:"content"`
+	Attachments    []model.Attachment `bson:"attachments,omitempty"`
+	Location       *model.Location    `bson:"location,omitempty"`
+	Timestamp      time.Time          `bson:"timestamp"`
+	ClientMsgID    string             `bson:"client_msg_id,omitempty"`
+	Status         string             `bson:"status"`
+	DeliveredAt    *time.Time         `bson:"delivered_at,omitempty"`
+	ReadAt         *time.Time         `bson:"read_at,omitempty"`
+	Recalled       bool               `bson:"recalled,omitempty"`
+	RecalledAt     *time.Time         `bson:"recalled_at,omitempty"`
+	EditedAt       *time.Time         `bson:"edited_at,omitempty"`
+	Edits          []messageEdit      `bson:"edits,omitempty"`       // 编辑历史，按编辑顺序记录每次被替换的内容
+	DeletedFor     []string           `bson:"deleted_for,omitempty"` // 已为自己删除该消息的用户
+	ExpireAt       *time.Time         `bson:"expire_at,omitempty"`   // 阅后即焚消息的到期时间
+	// EncryptedPayloads 端到端加密消息发给各设备的密文，加密消息的 content 为空
+	EncryptedPayloads []model.EncryptedPayload `bson:"encrypted_payloads,omitempty"`
+}
+
+// messageEdit 消息的一次编辑
+type messageEdit struct {
+	Content  string    `bson:"content"` // 编辑前的内容
+	EditedAt time.Time `bson:"edited_at"`
+}
+
+// toMessageStatus 将文档中的状态转换为接口中的枚举，缺少状态的旧消息视为已发送
+func toMessageStatus(status string) MessageStatus {
+	switch status {
+	case statusDelivered:
+		return MessageStatus_DELIVERED
+	case statusRead:
+		return MessageStatus_READ
+	default:
+		return MessageStatus_SENT
+	}
+}
+
+// toMessageItem 转换为接口中的消息项
+func (d *messageDocument) toMessageItem() *MessageItem {
+	return &MessageItem{
+		MessageId:         formatMessageID(d.ID),
+		Status:            toMessageStatus(d.Status),
+		From:              d.From,
+		To:                d.To,
+		GroupId:           d.GroupID,
+		Type:              toMessageType(d.Type),
+		Content:           d.Content,
+		Attachments:       toAttachments(d.Attachments),
+		Location:          toLocation(d.Location),
+		Timestamp:         d.Timestamp.Format(time.RFC3339),
+		ConversationId:    d.conversationID(),
+		Seq:               d.Seq,
+		Recalled:          d.Recalled,
+		EditedAt:          formatTime(d.EditedAt),
+		ExpireAt:          formatTime(d.ExpireAt),
+		EncryptedPayloads: toEncryptedPayloads(d.EncryptedPayloads),
+	}
+}
+
+// formatTime 格式化可能为空的时间
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// errInvalidMessageID 客户端传入的消息 ID 无法解析
+var errInvalidMessageID = errors.New("无效的消息 ID")
+
+// formatMessageID 将文档中的 _id 转换为对外的消息 ID
+func formatMessageID(id interface{}) string {
+	switch v := id.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case primitive.ObjectID:
+		return v.Hex()
+	default:
+		return ""
+	}
+}
+
+// parseMessageID 将对外的消息 ID 转换为文档中的 _id，兼容早期的 ObjectID
+func parseMessageID(id string) (interface{}, error) {
+	if n, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return n, nil
+	}
+	if objectID, err := primitive.ObjectIDFromHex(id); err == nil {
+		return objectID, nil
+	}
+	return nil, errInvalidMessageID
+}
@@ -0,0 +1,210 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"im-service/internal/data/model"
+	"im-service/internal/rpc/attachment"
+	"strings"
+)
+
+const (
+	// maxImageAttachments 一条图片消息最多引用的图片数
+	maxImageAttachments = 9
+	// maxEncryptedPayloads 一条加密消息最多包含的密文数，即收发双方的设备总数
+	maxEncryptedPayloads = 32
+	// maxCiphertextSize 单个密文的最大字节数
+	maxCiphertextSize = 64 * 1024
+)
+
+// messagePayload 校验后的消息内容
+type messagePayload struct {
+	Type        string
+	Attachments []model.Attachment
+	Location    *model.Location
+	Encrypted   []model.EncryptedPayload
+}
+
+// apply 将附件、位置和密文写入待插入的消息文档，不包含时不写入字段
+func (p *messagePayload) apply(doc bson.M) {
+	if len(p.Attachments) > 0 {
+		doc["attachments"] = p.Attachments
+	}
+	if p.Location != nil {
+		doc["location"] = p.Location
+	}
+	if len(p.Encrypted) > 0 {
+		doc["encrypted_payloads"] = p.Encrypted
+	}
+}
+
+// payloadsFor 返回发给 recipient 各设备的密文
+func (p *messagePayload) payloadsFor(recipient string) []model.EncryptedPayload {
+	var payloads []model.EncryptedPayload
+	for _, payload := range p.Encrypted {
+		if payload.Recipient == recipient {
+			payloads = append(payloads, payload)
+		}
+	}
+	return payloads
+}
+
+// buildEncryptedPayload 校验单聊加密消息的密文，密文只能发给收发双方的设备，且至少包含一个接收者设备的密文
+// 服务端不解析密文，消息本身不能带有明文内容、附件或位置
+func buildEncryptedPayload(from, to, content string, attachmentIDs []string, location *Location, payloads []*EncryptedPayload) (*messagePayload, error) {
+	if content != "" || len(attachmentIDs) > 0 || location != nil {
+		return nil, errors.New("加密消息不能包含明文内容、附件或位置")
+	}
+	if len(payloads) == 0 || len(payloads) > maxEncryptedPayloads {
+		return nil, errors.New("密文数量不正确")
+	}
+	payload := &messagePayload{Type: model.MessageEncrypted}
+	toRecipient := false
+	for _, p := range payloads {
+		if p.Recipient != from && p.Recipient != to {
+			return nil, errors.New("密文的接收者必须是会话成员")
+		}
+		if p.DeviceId == "" || len(p.Ciphertext) == 0 || len(p.Ciphertext) > maxCiphertextSize {
+			return nil, errors.New("无效的密文")
+		}
+		toRecipient = toRecipient || p.Recipient == to
+		payload.Encrypted = append(payload.Encrypted, model.EncryptedPayload{
+			Recipient:  p.Recipient,
+			DeviceID:   p.DeviceId,
+			Type:       p.Type,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+	if !toRecipient {
+		return nil, errors.New("缺少接收者设备的密文")
+	}
+	return payload, nil
+}
+
+// buildPayload 按消息类型校验内容，并根据附件 ID 查询附件信息，附件必须属于发送者且已上传完成
+func (s *CustomMessageServiceServer) buildPayload(ctx context.Context, from string, messageType MessageType, content string, attachmentIDs []string, location *Location) (*messagePayload, error) {
+	switch messageType {
+	case MessageType_TEXT:
+		if content == "" {
+			return nil, errors.New("消息内容不能为空")
+		}
+		if len(attachmentIDs) > 0 || location != nil {
+			return nil, errors.New("文本消息不能包含附件或位置")
+		}
+		return &messagePayload{Type: model.MessageText}, nil
+
+	case MessageType_LOCATION:
+		if location == nil || location.Latitude < -90 || location.Latitude > 90 ||
+			location.Longitude < -180 || location.Longitude > 180 {
+			return nil, errors.New("无效的位置")
+		}
+		if len(attachmentIDs) > 0 {
+			return nil, errors.New("位置消息不能包含附件")
+		}
+		return &messagePayload{
+			Type: model.MessageLocation,
+			Location: &model.Location{
+				Latitude:  location.Latitude,
+				Longitude: location.Longitude,
+				Name:      location.Name,
+				Address:   location.Address,
+			},
+		}, nil
+
+	case MessageType_IMAGE, MessageType_FILE, MessageType_VOICE:
+		if location != nil {
+			return nil, errors.New("附件消息不能包含位置")
+		}
+		maxCount := 1
+		if messageType == MessageType_IMAGE {
+			maxCount = maxImageAttachments
+		}
+		if len(attachmentIDs) == 0 || len(attachmentIDs) > maxCount {
+			return nil, errors.New("附件数量不正确")
+		}
+		infos, err := attachment.LookupOwnedAttachments(ctx, s.mongoClient, from, attachmentIDs)
+		if err != nil {
+			return nil, err
+		}
+		payload := &messagePayload{Type: strings.ToLower(messageType.String())}
+		for _, info := range infos {
+			if messageType == MessageType_IMAGE && !strings.HasPrefix(info.MimeType, "image/") {
+				return nil, errors.New("图片消息只能引用图片")
+			}
+			if messageType == MessageType_VOICE && !strings.HasPrefix(info.MimeType, "audio/") {
+				return nil, errors.New("语音消息只能引用音频")
+			}
+			payload.Attachments = append(payload.Attachments, model.Attachment{
+				AttachmentID: info.AttachmentId,
+				FileName:     info.FileName,
+				MimeType:     info.MimeType,
+				Size:         info.Size,
+				SHA256:       info.Sha256,
+				Width:        info.Width,
+				Height:       info.Height,
+				DurationMs:   info.DurationMs,
+			})
+		}
+		return payload, nil
+
+	default:
+		return nil, errors.New("不支持的消息类型")
+	}
+}
+
+// toMessageType 将文档中的类型转换为接口中的枚举，缺少类型的旧消息视为文本
+func toMessageType(messageType string) MessageType {
+	return MessageType(MessageType_value[strings.ToUpper(messageType)])
+}
+
+// toAttachments 将文档中的附件转换为接口中的附件
+func toAttachments(attachments []model.Attachment) []*Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	items := make([]*Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		items = append(items, &Attachment{
+			AttachmentId: a.AttachmentID,
+			FileName:     a.FileName,
+			MimeType:     a.MimeType,
+			Size:         a.Size,
+			Sha256:       a.SHA256,
+			Width:        a.Width,
+			Height:       a.Height,
+			DurationMs:   a.DurationMs,
+		})
+	}
+	return items
+}
+
+// toEncryptedPayloads 将文档中的密文转换为接口中的密文
+func toEncryptedPayloads(payloads []model.EncryptedPayload) []*EncryptedPayload {
+	if len(payloads) == 0 {
+		return nil
+	}
+	items := make([]*EncryptedPayload, 0, len(payloads))
+	for _, p := range payloads {
+		items = append(items, &EncryptedPayload{
+			Recipient:  p.Recipient,
+			DeviceId:   p.DeviceID,
+			Type:       p.Type,
+			Ciphertext: p.Ciphertext,
+		})
+	}
+	return items
+}
+
+// toLocation 将文档中的位置转换为接口中的位置
+func toLocation(location *model.Location) *Location {
+	if location == nil {
+		return nil
+	}
+	return &Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+		Name:      location.Name,
+		Address:   location.Address,
+	}
+}
@@ -0,0 +1,227 @@
+package message
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/kafka"
+	"log"
+	"time"
+)
+
+const (
+	// defaultReceiptLimit 查询回执的默认条数
+	defaultReceiptLimit = 50
+	// maxReceiptLimit 查询回执的最大条数
+	maxReceiptLimit = 200
+)
+
+// AckMessages 接收者确认消息已送达，将仍处于已发送状态的消息标记为已送达，并向发送者推送回执
+func (s *CustomMessageServiceServer) AckMessages(ctx context.Context, req *AckMessagesRequest) (*AckMessagesResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &AckMessagesResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	// 非法的消息 ID 直接忽略
+	ids := make([]interface{}, 0, len(req.MessageIds))
+	for _, id := range req.MessageIds {
+		messageID, err := parseMessageID(id)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, messageID)
+	}
+	if len(ids) == 0 {
+		return &AckMessagesResponse{Success: true}, nil
+	}
+
+	// 只处理发给自己且尚未送达的消息，重复确认不会重复推送回执
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	filter := bson.M{
+		"_id":    bson.M{"$in": ids},
+		"to":     req.Username,
+		"status": bson.M{"$in": []interface{}{statusSent, nil}},
+	}
+	cursor, err := messagesCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"from": 1}))
+	if err != nil {
+		return &AckMessagesResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var docs []messageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return &AckMessagesResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if len(docs) == 0 {
+		return &AckMessagesResponse{Success: true}, nil
+	}
+
+	now := time.Now()
+	_, err = messagesCollection.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"status": statusDelivered, "delivered_at": now},
+	})
+	if err != nil {
+		return &AckMessagesResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 按发送者分组推送送达回执
+	bySender := make(map[string][]string)
+	for _, doc := range docs {
+		bySender[doc.From] = append(bySender[doc.From], formatMessageID(doc.ID))
+	}
+	for sender, messageIDs := range bySender {
+		if err := s.kafkaProducer.SendReceipt(req.Username, sender, kafka.ReceiptDelivered, messageIDs, ""); err != nil {
+			log.Printf("发送送达回执到 Kafka 失败: %v", err)
+		}
+	}
+
+	return &AckMessagesResponse{Success: true}, nil
+}
+
+// MarkRead 将 peer 发给用户的消息标记为已读，直到 up_to_message_id（含），并向 peer 推送已读回执
+func (s *CustomMessageServiceServer) MarkRead(ctx context.Context, req *MarkReadRequest) (*MarkReadResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &MarkReadResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	upToID, err := parseMessageID(req.UpToMessageId)
+	if err != nil {
+		return &MarkReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 以目标消息的时间为界，该会话中此前收到的消息都视为已读
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	var upTo messageDocument
+	err = messagesCollection.FindOne(ctx, bson.M{
+		"_id":  upToID,
+		"from": req.Peer,
+		"to":   req.Username,
+	}).Decode(&upTo)
+	if err == mongo.ErrNoDocuments {
+		return &MarkReadResponse{
+			Success:  false,
+			ErrorMsg: "消息不存在",
+		}, nil
+	}
+	if err != nil {
+		return &MarkReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	if _, err := s.markP2PRead(ctx, req.Username, req.Peer, &upTo); err != nil {
+		return &MarkReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	return &MarkReadResponse{Success: true}, nil
+}
+
+// GetReceipts 查询用户发给 peer 的最近消息的投递状态
+func (s *CustomMessageServiceServer) GetReceipts(ctx context.Context, req *GetReceiptsRequest) (*GetReceiptsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetReceiptsResponse{
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = defaultReceiptLimit
+	}
+	if limit > maxReceiptLimit {
+		limit = maxReceiptLimit
+	}
+
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	// 与历史记录一致，已过期的消息不再返回
+	filter := bson.M{"$and": []bson.M{
+		{"from": req.Username, "to": req.Peer},
+		s.unexpiredFilter(time.Now()),
+	}}
+	opts := options.Find().
+		SetSort(bson.M{"timestamp": -1}).
+		SetLimit(limit).
+		SetProjection(bson.M{"content": 0})
+	cursor, err := messagesCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []messageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	receipts := make([]*ReceiptItem, 0, len(docs))
+	for _, doc := range docs {
+		receipts = append(receipts, &ReceiptItem{
+			MessageId:   formatMessageID(doc.ID),
+			Status:      toMessageStatus(doc.Status),
+			DeliveredAt: formatTime(doc.DeliveredAt),
+			ReadAt:      formatTime(doc.ReadAt),
+		})
+	}
+
+	lastDelivered, err := s.lastMessageWithStatus(ctx, req.Username, req.Peer, statusDelivered, statusRead)
+	if err != nil {
+		return nil, err
+	}
+	lastRead, err := s.lastMessageWithStatus(ctx, req.Username, req.Peer, statusRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetReceiptsResponse{
+		Receipts:               receipts,
+		LastDeliveredMessageId: lastDelivered,
+		LastReadMessageId:      lastRead,
+	}, nil
+}
+
+// lastMessageWithStatus 返回 from 发给 to 的未过期消息中处于指定状态的最新一条的 ID，没有时返回空
+func (s *CustomMessageServiceServer) lastMessageWithStatus(ctx context.Context, from, to string, statuses ...string) (string, error) {
+	var doc messageDocument
+	err := s.mongoClient.DB.Collection("messages").FindOne(ctx,
+		bson.M{"$and": []bson.M{
+			{"from": from, "to": to, "status": bson.M{"$in": statuses}},
+			s.unexpiredFilter(time.Now()),
+		}},
+		options.FindOne().SetSort(bson.M{"timestamp": -1}).SetProjection(bson.M{"_id": 1}),
+	).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return formatMessageID(doc.ID), nil
+}
@@ -0,0 +1,363 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/rpc/group"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	// minDisappearingTimer 阅后即焚的最短时长
+	minDisappearingTimer = 5 * time.Second
+	// maxDisappearingTimer 阅后即焚的最长时长
+	maxDisappearingTimer = 365 * 24 * time.Hour
+	// defaultPurgeInterval 未配置时清理过期消息的间隔
+	defaultPurgeInterval = time.Minute
+	// defaultPurgeBatchSize 未配置时每批清理的消息数
+	defaultPurgeBatchSize = 500
+	// purgeJobName 清理过期消息任务的名称，用于多节点间领取任务
+	purgeJobName = "purge_messages"
+)
+
+var errInvalidDisappearingTimer = errors.New("阅后即焚时长无效")
+
+// conversationSettingDocument conversation_settings 集合中的文档，保存会话所有成员共享的设置，_id 为会话 ID
+type conversationSettingDocument struct {
+	ConversationID      string    `bson:"_id"`
+	DisappearingSeconds int64     `bson:"disappearing_seconds"`
+	UpdatedBy           string    `bson:"updated_by"`
+	UpdatedAt           time.Time `bson:"updated_at"`
+	Encrypted           bool      `bson:"encrypted,omitempty"` // 已启用端到端加密，之后只能发送加密消息
+}
+
+// unexpiredFilter 返回未过期消息的查询条件：未超过全局保留时长，且阅后即焚消息未到期
+// 清理任务定期运行，查询时仍需过滤，保证过期消息在清理之前也不会返回
+func (s *CustomMessageServiceServer) unexpiredFilter(now time.Time) bson.M {
+	filter := bson.M{"expire_at": bson.M{"$not": bson.M{"$lte": now}}}
+	if s.retention > 0 {
+		filter["timestamp"] = bson.M{"$gt": now.Add(-s.retention)}
+	}
+	return filter
+}
+
+// expired 判断发送于 timestamp、阅后即焚到期时间为 expireAt 的消息是否已过期
+func (s *CustomMessageServiceServer) expired(timestamp time.Time, expireAt *time.Time, now time.Time) bool {
+	if expireAt != nil && !expireAt.After(now) {
+		return true
+	}
+	return s.retention > 0 && !timestamp.After(now.Add(-s.retention))
+}
+
+// conversationSetting 查询会话的设置，没有设置过的会话返回默认值
+func (s *CustomMessageServiceServer) conversationSetting(ctx context.Context, conversationID string) (*conversationSettingDocument, error) {
+	setting := &conversationSettingDocument{ConversationID: conversationID}
+	err := s.mongoClient.DB.Collection("conversation_settings").FindOne(ctx, bson.M{"_id": conversationID}).Decode(setting)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return setting, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return setting, nil
+}
+
+// expireAt 返回会话中此时发送的消息的阅后即焚到期时间，会话未开启阅后即焚时返回 nil
+func (d *conversationSettingDocument) expireAt(now time.Time) *time.Time {
+	if d.DisappearingSeconds <= 0 {
+		return nil
+	}
+	expireAt := now.Add(time.Duration(d.DisappearingSeconds) * time.Second)
+	return &expireAt
+}
+
+// expireAtMillis 将可能为空的到期时间转换为毫秒，为空时返回 0
+func expireAtMillis(expireAt *time.Time) int64 {
+	if expireAt == nil {
+		return 0
+	}
+	return expireAt.UnixMilli()
+}
+
+// conversationTargets 返回会话的所有成员，username 必须是会话成员
+func (s *CustomMessageServiceServer) conversationTargets(ctx context.Context, conversationID, username string) ([]string, error) {
+	if strings.HasPrefix(conversationID, groupConversationPrefix) {
+		return group.ListMemberNames(ctx, s.mongoClient, strings.TrimPrefix(conversationID, groupConversationPrefix))
+	}
+	peer, ok := conversationPeer(conversationID, username)
+	if !ok {
+		return nil, errInvalidConversation
+	}
+	return []string{peer, username}, nil
+}
+
+// SetDisappearingTimer 设置会话的阅后即焚时长，会话的任一成员都可以设置，只对之后发送的消息生效
+func (s *CustomMessageServiceServer) SetDisappearingTimer(ctx context.Context, req *SetDisappearingTimerRequest) (*SetDisappearingTimerResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SetDisappearingTimerResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	timer := time.Duration(req.Seconds) * time.Second
+	if req.Seconds < 0 || (req.Seconds > 0 && (timer < minDisappearingTimer || timer > maxDisappearingTimer)) {
+		return &SetDisappearingTimerResponse{
+			Success:  false,
+			ErrorMsg: errInvalidDisappearingTimer.Error(),
+		}, nil
+	}
+	if err := s.checkConversationMember(ctx, req.ConversationId, req.Username); err != nil {
+		return &SetDisappearingTimerResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	_, err := s.mongoClient.DB.Collection("conversation_settings").UpdateOne(ctx,
+		bson.M{"_id": req.ConversationId},
+		bson.M{"$set": bson.M{
+			"disappearing_seconds": req.Seconds,
+			"updated_by":           req.Username,
+			"updated_at":           time.Now(),
+		}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return &SetDisappearingTimerResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	// 会话索引中的时长只用于展示，更新失败时下一条消息会重新写入
+	_, err = s.mongoClient.DB.Collection("conversations").UpdateMany(ctx,
+		bson.M{"conversation_id": req.ConversationId},
+		bson.M{"$set": bson.M{"disappearing_seconds": req.Seconds}},
+	)
+	if err != nil {
+		log.Printf("更新会话 %s 的阅后即焚时长失败: %v", req.ConversationId, err)
+	}
+
+	targets, err := s.conversationTargets(ctx, req.ConversationId, req.Username)
+	if err != nil {
+		log.Printf("查询会话 %s 的成员失败: %v", req.ConversationId, err)
+	} else if err := s.kafkaProducer.SendDisappearingTimer(req.ConversationId, req.Username, req.Seconds, targets); err != nil {
+		log.Printf("发送阅后即焚时长变化事件到 Kafka 失败: %v", err)
+	}
+
+	return &SetDisappearingTimerResponse{Success: true}, nil
+}
+
+// RunPurger 定期清理过期消息，多个节点同时运行时每个周期只有一个节点执行，owner 为本节点的标识
+func (s *CustomMessageServiceServer) RunPurger(ctx context.Context, owner string, interval time.Duration, batchSize int, archive bool) {
+	if interval <= 0 {
+		interval = defaultPurgeInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+	s.purgeArchivedDisappearing(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// 领取标记略短于间隔，保证下一个周期可以重新领取；执行期间持续续约，超过一个周期时其他节点不会同时执行
+		claimed, err := s.redisClient.ClaimJob(ctx, purgeJobName, owner, interval*9/10)
+		if err != nil {
+			log.Printf("领取过期消息清理任务失败: %v", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		jobCtx, release := s.redisClient.HoldJob(ctx, purgeJobName, owner, interval*9/10)
+		var total int
+		for jobCtx.Err() == nil {
+			n, err := s.purgeExpired(jobCtx, batchSize, archive)
+			total += n
+			if err != nil {
+				log.Printf("清理过期消息失败: %v", err)
+				break
+			}
+			if n < batchSize {
+				break
+			}
+		}
+		release()
+		if total > 0 {
+			log.Printf("清理了 %d 条过期消息", total)
+		}
+	}
+}
+
+// expiredConversation 一批过期消息中属于同一会话的消息
+type expiredConversation struct {
+	messageIDs []string
+	targets    []string
+}
+
+// archivable 判断过期消息是否可以归档：只归档因全局保留时长过期的普通消息，
+// 阅后即焚消息无论是否已到期都不保留任何副本
+func archivable(doc *messageDocument) bool {
+	return doc.ExpireAt == nil
+}
+
+// purgeArchivedDisappearing 删除旧版本归档的阅后即焚消息
+func (s *CustomMessageServiceServer) purgeArchivedDisappearing(ctx context.Context) {
+	result, err := s.mongoClient.DB.Collection("messages_archive").DeleteMany(ctx, bson.M{"expire_at": bson.M{"$exists": true}})
+	if err != nil {
+		log.Printf("删除已归档的阅后即焚消息失败: %v", err)
+		return
+	}
+	if result.DeletedCount > 0 {
+		log.Printf("删除了 %d 条已归档的阅后即焚消息", result.DeletedCount)
+	}
+}
+
+// purgeExpired 清理一批过期消息，archive 为 true 时先将其中的普通消息复制到 messages_archive，返回清理的条数
+func (s *CustomMessageServiceServer) purgeExpired(ctx context.Context, batchSize int, archive bool) (int, error) {
+	now := time.Now()
+	conditions := []bson.M{{"expire_at": bson.M{"$lte": now}}}
+	if s.retention > 0 {
+		conditions = append(conditions, bson.M{"timestamp": bson.M{"$lte": now.Add(-s.retention)}})
+	}
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	cursor, err := messagesCollection.Find(ctx, bson.M{"$or": conditions}, options.Find().SetLimit(int64(batchSize)))
+	if err != nil {
+		return 0, err
+	}
+	var raws []bson.Raw
+	if err := cursor.All(ctx, &raws); err != nil {
+		return 0, err
+	}
+	if len(raws) == 0 {
+		return 0, nil
+	}
+
+	docs := make([]messageDocument, len(raws))
+	ids := make([]interface{}, len(raws))
+	for i, raw := range raws {
+		if err := bson.Unmarshal(raw, &docs[i]); err != nil {
+			return 0, err
+		}
+		ids[i] = docs[i].ID
+	}
+
+	if archive {
+		// 阅后即焚消息直接删除，只归档超过全局保留时长的普通消息
+		var archived []interface{}
+		for i, raw := range raws {
+			if archivable(&docs[i]) {
+				archived = append(archived, raw)
+			}
+		}
+		if len(archived) > 0 {
+			// 上次归档后删除失败的消息会再次归档，忽略重复
+			_, err := s.mongoClient.DB.Collection("messages_archive").InsertMany(ctx, archived, options.InsertMany().SetOrdered(false))
+			if err != nil && !mongo.IsDuplicateKeyError(err) {
+				return 0, err
+			}
+		}
+	}
+	if _, err := messagesCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return 0, err
+	}
+
+	s.afterPurge(ctx, docs)
+	return len(docs), nil
+}
+
+// afterPurge 清理过期消息后更新会话索引、离线收件箱和未读数，并通知会话成员删除本地副本
+func (s *CustomMessageServiceServer) afterPurge(ctx context.Context, docs []messageDocument) {
+	conversations := map[string]*expiredConversation{}
+	groupMembers := map[string][]string{}
+	inbox := map[string][]string{}
+	var messageIDs []string
+	var conversationIDs []string
+	for i := range docs {
+		doc := &docs[i]
+		messageID := formatMessageID(doc.ID)
+		messageIDs = append(messageIDs, messageID)
+		if doc.To != "" && doc.Status == statusSent {
+			inbox[doc.To] = append(inbox[doc.To], messageID)
+		}
+
+		conversationID := doc.conversationID()
+		conversation, ok := conversations[conversationID]
+		if !ok {
+			conversation = &expiredConversation{}
+			if doc.GroupID == "" {
+				conversation.targets = []string{doc.To, doc.From}
+			} else {
+				members, ok := groupMembers[doc.GroupID]
+				if !ok {
+					var err error
+					if members, err = group.ListMemberNames(ctx, s.mongoClient, doc.GroupID); err != nil {
+						log.Printf("查询群组 %s 的成员失败: %v", doc.GroupID, err)
+					}
+					groupMembers[doc.GroupID] = members
+				}
+				conversation.targets = members
+			}
+			conversations[conversationID] = conversation
+			conversationIDs = append(conversationIDs, conversationID)
+		}
+		conversation.messageIDs = append(conversation.messageIDs, messageID)
+	}
+
+	conversationsCollection := s.mongoClient.DB.Collection("conversations")
+	_, err := conversationsCollection.UpdateMany(ctx,
+		bson.M{"last_message.message_id": bson.M{"$in": messageIDs}},
+		bson.M{"$unset": bson.M{"last_message": ""}},
+	)
+	if err != nil {
+		log.Printf("移除会话中过期的最后一条消息失败: %v", err)
+	}
+	for username, ids := range inbox {
+		if err := s.redisClient.RemoveInbox(ctx, username, ids...); err != nil {
+			log.Printf("从用户 %s 的离线收件箱移除过期消息失败: %v", username, err)
+		}
+	}
+
+	// 过期的消息可能仍计入未读，重新统计有未读的会话
+	cursor, err := conversationsCollection.Find(ctx,
+		bson.M{"conversation_id": bson.M{"$in": conversationIDs}, "unread_count": bson.M{"$gt": 0}},
+		options.Find().SetProjection(bson.M{"owner": 1, "conversation_id": 1}),
+	)
+	if err != nil {
+		log.Printf("查询过期消息所在的会话失败: %v", err)
+	} else {
+		var unread []conversationDocument
+		if err := cursor.All(ctx, &unread); err != nil {
+			log.Printf("查询过期消息所在的会话失败: %v", err)
+		}
+		for _, conversation := range unread {
+			if _, err := s.refreshUnread(ctx, conversation.Owner, conversation.ConversationID); err != nil {
+				log.Printf("更新用户 %s 的未读数失败: %v", conversation.Owner, err)
+			}
+		}
+	}
+
+	for _, conversationID := range conversationIDs {
+		conversation := conversations[conversationID]
+		if len(conversation.targets) == 0 {
+			continue
+		}
+		if err := s.kafkaProducer.SendMessagesExpired(conversationID, conversation.messageIDs, conversation.targets); err != nil {
+			log.Printf("发送消息过期事件到 Kafka 失败: %v", err)
+		}
+	}
+}
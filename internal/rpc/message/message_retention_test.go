@@ -0,0 +1,28 @@
+package message
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArchivable(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	tests := []struct {
+		name string
+		doc  messageDocument
+		want bool
+	}{
+		{name: "超过保留时长的普通消息", doc: messageDocument{Timestamp: now.Add(-365 * 24 * time.Hour)}, want: true},
+		{name: "阅后即焚到期", doc: messageDocument{Timestamp: past, ExpireAt: &past}, want: false},
+		{name: "超过保留时长但阅后即焚未到期", doc: messageDocument{Timestamp: now.Add(-365 * 24 * time.Hour), ExpireAt: &future}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := archivable(&tt.doc); got != tt.want {
+				t.Errorf("archivable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/model"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/rpc/group"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// maxSearchQueryLength 搜索语句的最大长度，按 Unicode 码点计算
+	maxSearchQueryLength = 100
+	// snippetContext 摘要中命中位置之前保留的字符数
+	snippetContext = 20
+	// snippetLength 摘要的最大字符数
+	snippetLength = 80
+	// backfillBatchSize 补齐搜索字段时每批处理的消息数
+	backfillBatchSize = 500
+)
+
+// errEmptySearchQuery 搜索语句中没有可搜索的内容
+var errEmptySearchQuery = errors.New("搜索关键词不能为空")
+
+// isCJK 判断字符是否为中日韩文字，这些文字之间没有空格分词
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// tokenize 将文本切分为小写的词：字母和数字按连续的词切分，中日韩文字切分为相邻两字的二元组
+// unigrams 为 true 时同时输出单字，建立索引时使用，使单字查询也能命中；查询时只有单字的片段才输出单字
+func tokenize(text string, unigrams bool) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	emit := func(token string) {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+
+	var word []rune
+	var run []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			emit(string(word))
+			word = word[:0]
+		}
+	}
+	flushRun := func() {
+		for i := range run {
+			if unigrams || len(run) == 1 {
+				emit(string(run[i]))
+			}
+			if i+1 < len(run) {
+				emit(string(run[i : i+2]))
+			}
+		}
+		run = run[:0]
+	}
+
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushRun()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushRun()
+		}
+	}
+	flushWord()
+	flushRun()
+	return tokens
+}
+
+// searchText 返回写入消息文档 search_text 字段的内容，文本索引建立在该字段上
+// MongoDB 的文本索引不会切分中文，因此预先切分好并以空格连接
+func searchText(content string) string {
+	return strings.Join(tokenize(content, true), " ")
+}
+
+// parseSearchQuery 将搜索语句拆分为关键词，双引号括起的内容作为一个短语
+func parseSearchQuery(query string) []string {
+	var terms []string
+	for i, part := range strings.Split(query, `"`) {
+		// 奇数位置在引号内，未闭合的引号按短语处理到结尾
+		if i%2 == 1 {
+			if phrase := strings.Join(strings.Fields(part), " "); phrase != "" {
+				terms = append(terms, phrase)
+			}
+			continue
+		}
+		terms = append(terms, strings.Fields(part)...)
+	}
+	return terms
+}
+
+// isWordRune 判断字符是否属于以空格分词的词，这类关键词需要按词边界匹配
+func isWordRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// termPattern 返回匹配关键词的正则表达式
+// 短语中两个词之间的空白可以匹配任意空白，中文等不以空格分词的文字之间也可以没有空白
+func termPattern(term string) string {
+	words := strings.Fields(term)
+	var b strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			previous := []rune(words[i-1])
+			if isWordRune(previous[len(previous)-1]) && isWordRune([]rune(word)[0]) {
+				b.WriteString(`\s+`)
+			} else {
+				b.WriteString(`\s*`)
+			}
+		}
+		b.WriteString(regexp.QuoteMeta(word))
+	}
+	pattern := b.String()
+	runes := []rune(term)
+	if isWordRune(runes[0]) {
+		pattern = `\b` + pattern
+	}
+	if isWordRune(runes[len(runes)-1]) {
+		pattern += `\b`
+	}
+	return pattern
+}
+
+// searchFilter 返回搜索语句对应的查询条件
+// 文本索引按词的并集筛选候选消息，再由正则表达式保证每个关键词或短语都出现在内容中
+func searchFilter(terms []string) (bson.M, error) {
+	var tokens []string
+	conditions := make([]bson.M, 0, len(terms)+1)
+	for _, term := range terms {
+		termTokens := tokenize(term, false)
+		if len(termTokens) == 0 {
+			continue
+		}
+		tokens = append(tokens, termTokens...)
+		conditions = append(conditions, bson.M{"content": bson.M{"$regex": termPattern(term), "$options": "i"}})
+	}
+	if len(tokens) == 0 {
+		return nil, errEmptySearchQuery
+	}
+	conditions = append(conditions, bson.M{"$text": bson.M{"$search": strings.Join(tokens, " ")}})
+	return bson.M{"$and": conditions}, nil
+}
+
+// typeFilter 返回消息类型的查询条件，早期消息没有类型，按文本处理
+func typeFilter(types []MessageType) bson.M {
+	values := make([]string, 0, len(types))
+	includeText := false
+	for _, messageType := range types {
+		if messageType == MessageType_TEXT {
+			includeText = true
+		}
+		values = append(values, strings.ToLower(messageType.String()))
+	}
+	if includeText {
+		return bson.M{"$or": []bson.M{
+			{"type": bson.M{"$in": values}},
+			{"type": bson.M{"$exists": false}},
+		}}
+	}
+	return bson.M{"type": bson.M{"$in": values}}
+}
+
+// highlightRanges 返回内容中所有关键词出现的位置，按 Unicode 码点计算，重叠的位置会合并
+func highlightRanges(content []rune, terms []string) []*Highlight {
+	lower := make([]rune, len(content))
+	for i, r := range content {
+		lower[i] = unicode.ToLower(r)
+	}
+	matched := make([]bool, len(content))
+	for _, term := range terms {
+		// 短语中的每个词分别高亮
+		for _, word := range strings.Fields(term) {
+			needle := []rune(strings.ToLower(word))
+			for i := 0; i+len(needle) <= len(lower); i++ {
+				if string(lower[i:i+len(needle)]) == string(needle) {
+					for j := i; j < i+len(needle); j++ {
+						matched[j] = true
+					}
+				}
+			}
+		}
+	}
+
+	var highlights []*Highlight
+	for i := 0; i < len(matched); i++ {
+		if !matched[i] {
+			continue
+		}
+		start := i
+		for i < len(matched) && matched[i] {
+			i++
+		}
+		highlights = append(highlights, &Highlight{Offset: int32(start), Length: int32(i - start)})
+	}
+	return highlights
+}
+
+// buildSnippet 截取第一个命中位置附近的内容作为摘要，并返回摘要中需要高亮的位置
+func buildSnippet(content string, terms []string) (string, []*Highlight) {
+	runes := []rune(content)
+	highlights := highlightRanges(runes, terms)
+	if len(runes) <= snippetLength {
+		return content, highlights
+	}
+
+	start := 0
+	if len(highlights) > 0 {
+		start = int(highlights[0].Offset) - snippetContext
+	}
+	start = max(0, min(start, len(runes)-snippetLength))
+	end := start + snippetLength
+
+	prefix := ""
+	if start > 0 {
+		prefix = "…"
+	}
+	suffix := ""
+	if end < len(runes) {
+		suffix = "…"
+	}
+	shift := int32(len([]rune(prefix)))
+	visible := make([]*Highlight, 0, len(highlights))
+	for _, h := range highlights {
+		from := max(int(h.Offset), start)
+		to := min(int(h.Offset+h.Length), end)
+		if from >= to {
+			continue
+		}
+		visible = append(visible, &Highlight{Offset: int32(from-start) + shift, Length: int32(to - from)})
+	}
+	return prefix + string(runes[start:end]) + suffix, visible
+}
+
+// parseSearchTime 解析搜索条件中的时间，为空时返回 nil
+func parseSearchTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New("无效的时间")
+	}
+	return &t, nil
+}
+
+// SearchMessages 在调用者参与的单聊和所在群组中搜索消息，按时间倒序分页
+func (s *CustomMessageServiceServer) SearchMessages(ctx context.Context, req *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SearchMessagesResponse{
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if len([]rune(req.Query)) > maxSearchQueryLength {
+		return &SearchMessagesResponse{
+			ErrorMsg: "搜索关键词过长",
+		}, nil
+	}
+	if req.Peer != "" && req.GroupId != "" {
+		return &SearchMessagesResponse{
+			ErrorMsg: "不能同时指定用户和群组",
+		}, nil
+	}
+
+	terms := parseSearchQuery(req.Query)
+	textCondition, err := searchFilter(terms)
+	if err != nil {
+		return &SearchMessagesResponse{
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	conditions := []bson.M{
+		textCondition,
+		{"deleted_for": bson.M{"$ne": req.Username}},
+		{"type": bson.M{"$ne": model.MessageEncrypted}},
+	}
+
+	// 服务端没有加密会话的明文，这些会话中启用加密之前的消息同样不参与搜索
+	encryptedPeers, err := s.encryptedConversationPeers(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// 限定在调用者参与的会话中
+	switch {
+	case req.Peer != "":
+		if slices.Contains(encryptedPeers, req.Peer) {
+			return &SearchMessagesResponse{}, nil
+		}
+		conditions = append(conditions, bson.M{"participants": participants(req.Username, req.Peer)})
+	case req.GroupId != "":
+		role, err := group.GetMemberRole(ctx, s.mongoClient, req.GroupId, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		if role == "" {
+			return &SearchMessagesResponse{
+				ErrorMsg: "你不是群成员",
+			}, nil
+		}
+		conditions = append(conditions, bson.M{"group_id": req.GroupId})
+	default:
+		groupIDs, err := group.ListUserGroupIDs(ctx, s.mongoClient, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{"participants": req.Username},
+			{"group_id": bson.M{"$in": groupIDs}},
+		}})
+		if len(encryptedPeers) > 0 {
+			excluded := make([]bson.M, 0, len(encryptedPeers))
+			for _, peer := range encryptedPeers {
+				excluded = append(excluded, bson.M{"participants": participants(req.Username, peer)})
+			}
+			conditions = append(conditions, bson.M{"$nor": excluded})
+		}
+	}
+
+	startTime, err := parseSearchTime(req.StartTime)
+	if err != nil {
+		return &SearchMessagesResponse{
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	endTime, err := parseSearchTime(req.EndTime)
+	if err != nil {
+		return &SearchMessagesResponse{
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if startTime != nil {
+		conditions = append(conditions, bson.M{"timestamp": bson.M{"$gte": *startTime}})
+	}
+	if endTime != nil {
+		conditions = append(conditions, bson.M{"timestamp": bson.M{"$lt": *endTime}})
+	}
+	if len(req.Types) > 0 {
+		conditions = append(conditions, typeFilter(req.Types))
+	}
+
+	docs, nextCursor, hasMore, err := s.queryHistory(ctx, bson.M{"$and": conditions}, req.Limit, req.Before, "", SortOrder_DESC)
+	if errors.Is(err, errInvalidCursor) {
+		return &SearchMessagesResponse{
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*SearchResult, 0, len(docs))
+	for _, doc := range docs {
+		snippet, highlights := buildSnippet(doc.Content, terms)
+		results = append(results, &SearchResult{
+			Message:    doc.toMessageItem(),
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+
+	return &SearchMessagesResponse{
+		Results:    results,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
+	}, nil
+}
+
+// BackfillSearchText 为搜索功能上线前的消息补齐 search_text 字段，按 _id 顺序分批处理，每条消息只扫描一次
+func BackfillSearchText(ctx context.Context, mongoClient *mongodb.MongoClient) error {
+	messagesCollection := mongoClient.DB.Collection("messages")
+	opts := options.Find().
+		SetProjection(bson.M{"content": 1}).
+		SetSort(bson.M{"_id": 1}).
+		SetLimit(backfillBatchSize)
+
+	total := 0
+	// _id 比较只在同一 BSON 类型内有效，早期的 ObjectID 和雪花 ID 分开翻页
+	for _, idType := range []string{"objectId", "long"} {
+		var lastID interface{}
+		for {
+			idFilter := bson.M{"$type": idType}
+			if lastID != nil {
+				idFilter["$gt"] = lastID
+			}
+			filter := bson.M{
+				"_id":         idFilter,
+				"search_text": bson.M{"$exists": false},
+				"content":     bson.M{"$nin": bson.A{"", nil}},
+				"recalled":    bson.M{"$ne": true},
+			}
+			cursor, err := messagesCollection.Find(ctx, filter, opts)
+			if err != nil {
+				return err
+			}
+			var docs []messageDocument
+			if err := cursor.All(ctx, &docs); err != nil {
+				return err
+			}
+			if len(docs) == 0 {
+				break
+			}
+
+			models := make([]mongo.WriteModel, 0, len(docs))
+			for _, doc := range docs {
+				// 带上原内容作为条件，期间被编辑的消息已由编辑流程写入新的 search_text
+				models = append(models, mongo.NewUpdateOneModel().
+					SetFilter(bson.M{"_id": doc.ID, "content": doc.Content}).
+					SetUpdate(bson.M{"$set": bson.M{"search_text": searchText(doc.Content)}}))
+			}
+			if _, err := messagesCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+				return err
+			}
+			total += len(docs)
+			// 即使某条消息因内容已变化没有被更新，下一批也从它之后开始，不会重复扫描
+			lastID = docs[len(docs)-1].ID
+		}
+	}
+	if total > 0 {
+		log.Printf("已为 %d 条消息补齐搜索字段", total)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package message
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		unigrams bool
+		want     []string
+	}{
+		{name: "空文本", text: "", want: nil},
+		{name: "英文转小写", text: "Hello World", want: []string{"hello", "world"}},
+		{name: "数字和字母混合", text: "room42 ok", want: []string{"room42", "ok"}},
+		{name: "标点分隔", text: "a,b;c", want: []string{"a", "b", "c"}},
+		{name: "重复词去重", text: "go go GO", want: []string{"go"}},
+		{name: "中文二元组", text: "你好世界", want: []string{"你好", "好世", "世界"}},
+		{name: "中文单字查询", text: "你", want: []string{"你"}},
+		{name: "中文二元组附带单字", text: "你好", unigrams: true, want: []string{"你", "你好", "好"}},
+		{name: "中英混合", text: "开会meeting明天", want: []string{"开会", "meeting", "明天"}},
+		{name: "日文假名", text: "こんにちは", want: []string{"こん", "んに", "にち", "ちは"}},
+		{name: "标点打断中文", text: "你好，世界", want: []string{"你好", "世界"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenize(tt.text, tt.unigrams)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("tokenize(%q, %v) = %q, want %q", tt.text, tt.unigrams, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{content: "", want: ""},
+		{content: "Hello, World", want: "hello world"},
+		{content: "你好", want: "你 你好 好"},
+		{content: "明天8点", want: "明 明天 天 8 点"},
+	}
+	for _, tt := range tests {
+		if got := searchText(tt.content); got != tt.want {
+			t.Errorf("searchText(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
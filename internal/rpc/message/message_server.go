@@ -2,34 +2,73 @@ package message
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"im-service/internal/data/kafka"
+	"im-service/internal/data/model"
 	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+	"im-service/internal/general"
 	"im-service/internal/rpc/friend"
 	"log"
 	"time"
 )
 
+const (
+	// clientMsgDedupWindow 相同客户端消息 ID 的去重窗口
+	clientMsgDedupWindow = 24 * time.Hour
+	// defaultRecallWindow 未配置时发送者可以撤回消息的时限
+	defaultRecallWindow = 2 * time.Minute
+)
+
 // CustomMessageServiceServer 实现 MessageService 服务
 type CustomMessageServiceServer struct {
 	UnimplementedMessageServiceServer
 	kafkaProducer *kafka.KafkaProducer
 	mongoClient   *mongodb.MongoClient // 修改为新的类型
-	kafkaConsumer *kafka.KafkaConsumer
+	redisClient   *redis.RedisClient
+	idGenerator   *general.Snowflake
+	recallWindow  time.Duration
+	retention     time.Duration // 消息保留时长，为 0 时永久保留
 }
 
 // NewCustomMessageServiceServer 创建消息服务端实例
-func NewCustomMessageServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, kafkaConsumer *kafka.KafkaConsumer) *CustomMessageServiceServer {
+func NewCustomMessageServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient, idGenerator *general.Snowflake, recallWindow, retention time.Duration) *CustomMessageServiceServer {
+	if recallWindow <= 0 {
+		recallWindow = defaultRecallWindow
+	}
 	return &CustomMessageServiceServer{
 		kafkaProducer: kafkaProducer,
 		mongoClient:   mongoClient,
-		kafkaConsumer: kafkaConsumer,
+		redisClient:   redisClient,
+		idGenerator:   idGenerator,
+		recallWindow:  recallWindow,
+		retention:     retention,
 	}
 }
 
+// newMessageID 为新消息分配 ID；clientMsgID 在去重窗口内已使用过时返回之前分配的 ID 和 true
+func (s *CustomMessageServiceServer) newMessageID(ctx context.Context, from, clientMsgID string) (int64, string, bool, error) {
+	id, err := s.idGenerator.NextID()
+	if err != nil {
+		return 0, "", false, err
+	}
+	messageID := formatMessageID(id)
+	if clientMsgID == "" {
+		return id, messageID, false, nil
+	}
+	existing, claimed, err := s.redisClient.ClaimClientMessage(ctx, from, clientMsgID, messageID, clientMsgDedupWindow)
+	if err != nil {
+		// 去重记录不可用时照常发送，最坏情况是重试产生重复消息
+		log.Printf("记录客户端消息 ID 失败: %v", err)
+		return id, messageID, false, nil
+	}
+	if !claimed {
+		return 0, existing, true, nil
+	}
+	return id, messageID, false, nil
+}
+
 // SendMessage 处理发送消息请求
 func (s *CustomMessageServiceServer) SendMessage(ctx context.Context, req *SendMessageRequest) (*SendMessageResponse, error) {
 	//从上下文中获取用户名
@@ -43,7 +82,7 @@ func (s *CustomMessageServiceServer) SendMessage(ctx context.Context, req *SendM
 	}
 
 	// 检查发送者和接收者是否为好友
-	isFriend, err := friend.IsFriends(ctx, s.mongoClient, req.From, req.To)
+	isFriend, err := friend.IsFriends(ctx, s.mongoClient, s.redisClient, req.From, req.To)
 	if err != nil {
 		log.Printf("检查好友关系时出错: %v", err)
 		return &SendMessageResponse{
@@ -58,48 +97,194 @@ func (s *CustomMessageServiceServer) SendMessage(ctx context.Context, req *SendM
 			ErrorMsg: "发送者和接收者不是好友，无法发送消息",
 		}, nil
 	}
-	// 构建 "command|param1|param2..." 格式的消息
-	command := "sendMessage"
-	kafkaMessage := fmt.Sprintf("%s|%s|%s|%s", command, req.From, req.To, req.Content)
-	// 发送消息到 Kafka
-	err = s.kafkaProducer.SendMessage(kafkaMessage)
+	// 被对方拉黑时返回通用错误，不透露拉黑状态
+	if err := friend.CheckBlocked(ctx, s.mongoClient, req.From, req.To); err != nil {
+		return &SendMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var payload *messagePayload
+	switch {
+	case req.Type == MessageType_ENCRYPTED:
+		payload, err = buildEncryptedPayload(req.From, req.To, req.Content, req.AttachmentIds, req.Location, req.EncryptedPayloads)
+	case len(req.EncryptedPayloads) > 0:
+		err = errors.New("只有加密消息可以包含密文")
+	default:
+		payload, err = s.buildPayload(ctx, req.From, req.Type, req.Content, req.AttachmentIds, req.Location)
+	}
+	if err != nil {
+		return &SendMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 会话设置决定消息的到期时间，以及是否只能发送加密消息
+	conversationID := p2pConversationID(req.From, req.To)
+	setting, err := s.conversationSetting(ctx, conversationID)
+	if err != nil {
+		log.Printf("查询会话 %s 的设置失败: %v", conversationID, err)
+		return &SendMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	encrypted := payload.Type == model.MessageEncrypted
+	if setting.Encrypted && !encrypted {
+		return &SendMessageResponse{
+			Success:  false,
+			ErrorMsg: errEncryptedConversation.Error(),
+		}, nil
+	}
+
+	// 分配消息 ID，客户端重试的消息直接返回之前的 ID，不再重复存储和推送
+	id, messageID, duplicate, err := s.newMessageID(ctx, req.From, req.ClientMsgId)
 	if err != nil {
-		log.Printf("发送消息到 Kafka 失败: %v", err)
+		log.Printf("分配消息 ID 失败: %v", err)
 		return &SendMessageResponse{
 			Success:  false,
 			ErrorMsg: err.Error(),
 		}, nil
 	}
-	log.Printf("消息成功发送到 Kafka，内容: %s", kafkaMessage)
-	go func() {
-		err := s.kafkaConsumer.ConsumeMessages()
-		if err != nil {
-			log.Printf("Kafka 消费者出现错误")
-			log.Println(err)
+	if duplicate {
+		return &SendMessageResponse{
+			Success:   true,
+			MessageId: messageID,
+		}, nil
+	}
+
+	// 第一条加密消息使会话启用端到端加密，之后不再接受明文消息
+	if encrypted && !setting.Encrypted {
+		if err := s.enableEncryption(ctx, conversationID, req.From); err != nil {
+			log.Printf("会话 %s 启用端到端加密失败: %v", conversationID, err)
+			s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
+			return &SendMessageResponse{
+				Success:  false,
+				ErrorMsg: err.Error(),
+			}, nil
 		}
-	}()
+	}
+
+	// 会话开启阅后即焚时为消息设置到期时间
+	now := time.Now()
+	expireAt := setting.expireAt(now)
+
+	// 分配会话内的序列号，客户端据此发现并补齐缺失的消息
+	seq, err := s.nextSeq(ctx, conversationID)
+	if err != nil {
+		log.Printf("分配会话 %s 的序列号失败: %v", conversationID, err)
+		s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
+		return &SendMessageResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
 	// 插入消息到 MongoDB
 	messagesCollection := s.mongoClient.DB.Collection("messages")
 	message := bson.M{
-		"from":      req.From,
-		"to":        req.To,
-		"content":   req.Content,
-		"timestamp": time.Now(),
+		"_id":             id,
+		"client_msg_id":   req.ClientMsgId,
+		"from":            req.From,
+		"to":              req.To,
+		"participants":    participants(req.From, req.To),
+		"conversation_id": conversationID,
+		"seq":             seq,
+		"type":            payload.Type,
+		"content":         req.Content,
+		"search_text":     searchText(req.Content),
+		"timestamp":       now,
+		"status":          statusSent,
+	}
+	payload.apply(message)
+	if expireAt != nil {
+		message["expire_at"] = *expireAt
 	}
 	_, insertErr := messagesCollection.InsertOne(ctx, message)
 	if insertErr != nil {
+		s.releaseClientMsgID(ctx, req.From, req.ClientMsgId)
 		return &SendMessageResponse{
 			Success:  false,
 			ErrorMsg: insertErr.Error(),
 		}, nil
 	}
+	s.touchConversations(ctx, &messageDocument{
+		ID:             id,
+		From:           req.From,
+		To:             req.To,
+		ConversationID: conversationID,
+		Seq:            seq,
+		Type:           payload.Type,
+		Content:        req.Content,
+		Timestamp:      now,
+		ExpireAt:       expireAt,
+	}, []string{req.To, req.From})
+
+	// 无论接收者是否在线都先放入收件箱：实时推送可能在途中丢失，
+	// 客户端 ack 后由 HandleAck 移除，未确认的消息在下次连接时补推
+	err = s.redisClient.PushInbox(ctx, req.To, &redis.InboxItem{
+		MessageID:      messageID,
+		ConversationID: conversationID,
+		Seq:            seq,
+		From:           req.From,
+		To:             req.To,
+		Type:           payload.Type,
+		Content:        req.Content,
+		Attachments:    payload.Attachments,
+		Location:       payload.Location,
+		Timestamp:      now.UnixMilli(),
+		ExpireAt:       expireAtMillis(expireAt),
+		// 接收者只需要发给自己设备的密文
+		EncryptedPayloads: payload.payloadsFor(req.To),
+	})
+	if err != nil {
+		log.Printf("写入用户 %s 的离线收件箱失败: %v", req.To, err)
+	}
+
+	// 发送消息到 Kafka，由网关节点路由推送，发送者的其他设备同样会收到
+	err = s.kafkaProducer.SendEvent(&kafka.Event{
+		Type:              kafka.EventNewMessage,
+		MessageID:         messageID,
+		ClientMsgID:       req.ClientMsgId,
+		ConversationID:    conversationID,
+		Seq:               seq,
+		From:              req.From,
+		To:                req.To,
+		MessageType:       payload.Type,
+		Content:           req.Content,
+		Attachments:       payload.Attachments,
+		Location:          payload.Location,
+		EncryptedPayloads: payload.Encrypted,
+		MessageExpireAt:   expireAtMillis(expireAt),
+		Timestamp:         now.UnixMilli(),
+		Targets:           []string{req.To, req.From},
+	})
+	if err != nil {
+		// 消息已经落库，实时推送失败时由离线收件箱和增量同步补偿，不能向客户端返回失败，
+		// 否则客户端重试会因 client_msg_id 已被占用而拿不到消息 ID
+		log.Printf("发送消息到 Kafka 失败，消息 %s 已保存，等待同步补偿: %v", messageID, err)
+	} else {
+		log.Printf("消息成功发送到 Kafka，从 %s 到 %s", req.From, req.To)
+	}
 
 	return &SendMessageResponse{
-		Success:  true,
-		ErrorMsg: "",
+		Success:   true,
+		ErrorMsg:  "",
+		MessageId: messageID,
 	}, nil
 }
 
+// releaseClientMsgID 消息存储失败时移除去重记录，客户端可以使用相同的 clientMsgID 重试
+func (s *CustomMessageServiceServer) releaseClientMsgID(ctx context.Context, from, clientMsgID string) {
+	if clientMsgID == "" {
+		return
+	}
+	if err := s.redisClient.ReleaseClientMessage(ctx, from, clientMsgID); err != nil {
+		log.Printf("移除客户端消息 ID 失败: %v", err)
+	}
+}
+
 // GetMessageHistory 处理获取消息历史请求
 func (s *CustomMessageServiceServer) GetMessageHistory(ctx context.Context, req *GetMessageHistoryRequest) (*GetMessageHistoryResponse, error) {
 	//从上下文中获取用户名
@@ -112,42 +297,29 @@ func (s *CustomMessageServiceServer) GetMessageHistory(ctx context.Context, req
 		}, nil
 	}
 
-	messagesCollection := s.mongoClient.DB.Collection("messages")
-	filter := bson.M{
-		"$or": []bson.M{
-			{"from": req.From, "to": req.To},
-			{"from": req.To, "to": req.From},
-		},
-	}
-	opts := options.Find().SetSort(bson.M{"timestamp": -1}).SetLimit(int64(req.Limit))
-	cursor, err := messagesCollection.Find(ctx, filter, opts)
+	docs, nextCursor, hasMore, err := s.queryHistory(ctx,
+		bson.M{"participants": participants(req.From, req.To), "deleted_for": bson.M{"$ne": req.To}},
+		req.Limit, req.Before, req.After, req.Order,
+	)
+	if errors.Is(err, errInvalidCursor) {
+		return &GetMessageHistoryResponse{
+			Messages: nil,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var messages []*MessageItem
-	for cursor.Next(ctx) {
-		var msg bson.M
-		if err := cursor.Decode(&msg); err != nil {
-			return nil, err
-		}
-		// 处理 timestamp 字段
-		timestamp, ok := msg["timestamp"].(primitive.DateTime)
-		if !ok {
-			// 处理类型断言失败的情况
-			return nil, fmt.Errorf("failed to convert timestamp to primitive.DateTime")
-		}
-		messages = append(messages, &MessageItem{
-			From:      msg["from"].(string),
-			To:        msg["to"].(string),
-			Content:   msg["content"].(string),
-			Timestamp: timestamp.Time().Format(time.RFC3339), // 将 primitive.DateTime 转换为 time.Time
-		})
+	messages := make([]*MessageItem, 0, len(docs))
+	for _, doc := range docs {
+		messages = append(messages, doc.toMessageItem())
 	}
 
 	return &GetMessageHistoryResponse{
-		Messages: messages,
-		ErrorMsg: "",
+		Messages:   messages,
+		ErrorMsg:   "",
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
 	}, nil
 }
@@ -0,0 +1,216 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/rpc/group"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// p2pConversationPrefix 单聊会话 ID 前缀，后接按字典序排列的双方用户名
+	p2pConversationPrefix = "p2p:"
+	// groupConversationPrefix 群聊会话 ID 前缀，后接群组 ID
+	groupConversationPrefix = "group:"
+
+	// defaultSyncLimit 同步消息的默认条数
+	defaultSyncLimit = 100
+	// maxSyncLimit 同步消息的最大条数
+	maxSyncLimit = 500
+	// seqGapGrace 序列号缺口的等待时间，序列号先于写入分配，
+	// 缺口之后的消息写入时间不足该时长时，缺失的消息可能仍在写入，同步到缺口为止
+	seqGapGrace = 5 * time.Second
+)
+
+// errInvalidConversation 会话 ID 无法解析或调用者不是会话成员
+var errInvalidConversation = errors.New("无效的会话")
+
+// p2pConversationID 返回单聊会话 ID
+func p2pConversationID(user1, user2 string) string {
+	pair := participants(user1, user2)
+	return p2pConversationPrefix + pair[0] + ":" + pair[1]
+}
+
+// groupConversationID 返回群聊会话 ID
+func groupConversationID(groupID string) string {
+	return groupConversationPrefix + groupID
+}
+
+// conversationID 返回消息所属的会话 ID，早期消息未存储时根据双方或群组推算
+func (d *messageDocument) conversationID() string {
+	if d.ConversationID != "" {
+		return d.ConversationID
+	}
+	if d.GroupID != "" {
+		return groupConversationID(d.GroupID)
+	}
+	return p2pConversationID(d.From, d.To)
+}
+
+// conversationPeer 从单聊会话 ID 中解析出 username 的对方，username 不是会话成员时返回 false
+func conversationPeer(conversationID, username string) (string, bool) {
+	rest := strings.TrimPrefix(conversationID, p2pConversationPrefix)
+	// 用户名本身可能包含分隔符，解析后重新拼接校验
+	var peer string
+	switch {
+	case strings.HasPrefix(rest, username+":"):
+		peer = rest[len(username)+1:]
+	case strings.HasSuffix(rest, ":"+username):
+		peer = rest[:len(rest)-len(username)-1]
+	default:
+		return "", false
+	}
+	return peer, p2pConversationID(username, peer) == conversationID
+}
+
+// checkConversationMember 校验 username 是否为会话成员
+func (s *CustomMessageServiceServer) checkConversationMember(ctx context.Context, conversationID, username string) error {
+	switch {
+	case strings.HasPrefix(conversationID, p2pConversationPrefix):
+		if _, ok := conversationPeer(conversationID, username); !ok {
+			return errInvalidConversation
+		}
+		return nil
+	case strings.HasPrefix(conversationID, groupConversationPrefix):
+		role, err := group.GetMemberRole(ctx, s.mongoClient, strings.TrimPrefix(conversationID, groupConversationPrefix), username)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			return errInvalidConversation
+		}
+		return nil
+	default:
+		return errInvalidConversation
+	}
+}
+
+// nextSeq 为会话分配下一个序列号，Redis 中的计数器丢失时从已存储的最大序列号恢复
+func (s *CustomMessageServiceServer) nextSeq(ctx context.Context, conversationID string) (int64, error) {
+	seq, err := s.redisClient.NextSeq(ctx, conversationID)
+	if err != nil || seq != 0 {
+		return seq, err
+	}
+	floor, err := s.storedSeq(ctx, conversationID)
+	if err != nil {
+		return 0, err
+	}
+	return s.redisClient.InitSeq(ctx, conversationID, floor)
+}
+
+// storedSeq 返回会话中已存储消息的最大序列号
+func (s *CustomMessageServiceServer) storedSeq(ctx context.Context, conversationID string) (int64, error) {
+	var doc messageDocument
+	err := s.mongoClient.DB.Collection("messages").FindOne(ctx,
+		bson.M{"conversation_id": conversationID, "seq": bson.M{"$gt": 0}},
+		options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}}).SetProjection(bson.M{"seq": 1}),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return doc.Seq, nil
+}
+
+// latestSeq 返回会话最近分配的序列号
+func (s *CustomMessageServiceServer) latestSeq(ctx context.Context, conversationID string) (int64, error) {
+	seq, err := s.redisClient.GetSeq(ctx, conversationID)
+	if err != nil || seq != 0 {
+		return seq, err
+	}
+	return s.storedSeq(ctx, conversationID)
+}
+
+// seqGapCut 返回按序列号升序排列的 docs 中可以返回的条数：遇到序列号不连续、
+// 且缺口之后的消息写入不足 seqGapGrace 时截止，超过等待时间的缺口视为写入失败而跳过
+func seqGapCut(docs []messageDocument, sinceSeq int64, now time.Time) int {
+	expected := sinceSeq + 1
+	for i := range docs {
+		if docs[i].Seq != expected && now.Sub(docs[i].Timestamp) < seqGapGrace {
+			return i
+		}
+		expected = docs[i].Seq + 1
+	}
+	return len(docs)
+}
+
+// SyncMessages 按序列号返回会话中 since_seq 之后的消息
+func (s *CustomMessageServiceServer) SyncMessages(ctx context.Context, req *SyncMessagesRequest) (*SyncMessagesResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SyncMessagesResponse{
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if err := s.checkConversationMember(ctx, req.ConversationId, req.Username); err != nil {
+		return &SyncMessagesResponse{
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSyncLimit
+	}
+	if limit > maxSyncLimit {
+		limit = maxSyncLimit
+	}
+
+	// 先读取最新序列号再查询消息，保证返回的消息不会超过 latest_seq
+	latest, err := s.latestSeq(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "seq", Value: 1}}).
+		SetLimit(int64(limit) + 1)
+	cursor, err := s.mongoClient.DB.Collection("messages").Find(ctx, bson.M{
+		"conversation_id": req.ConversationId,
+		"seq":             bson.M{"$gt": req.SinceSeq, "$lte": latest},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []messageDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	hasMore := len(docs) > int(limit)
+	if hasMore {
+		docs = docs[:limit]
+	}
+
+	now := time.Now()
+	// 遇到可能仍在写入的缺口时只返回缺口之前的消息，客户端稍后再次同步
+	if n := seqGapCut(docs, req.SinceSeq, now); n < len(docs) {
+		docs = docs[:n]
+		hasMore = false
+	}
+
+	messages := make([]*MessageItem, 0, len(docs))
+	for _, doc := range docs {
+		// 用户为自己删除的消息和已过期的消息参与缺口判断，但不返回
+		if slices.Contains(doc.DeletedFor, req.Username) || s.expired(doc.Timestamp, doc.ExpireAt, now) {
+			continue
+		}
+		item := doc.toMessageItem()
+		// 保留毫秒，网关据此还原推送中的时间戳
+		item.Timestamp = doc.Timestamp.Format(time.RFC3339Nano)
+		messages = append(messages, item)
+	}
+
+	return &SyncMessagesResponse{
+		Messages:  messages,
+		LatestSeq: latest,
+		HasMore:   hasMore,
+	}, nil
+}
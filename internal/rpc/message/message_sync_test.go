@@ -0,0 +1,36 @@
+package message
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeqGapCut(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-time.Second)
+	old := now.Add(-seqGapGrace - time.Second)
+	doc := func(seq int64, ts time.Time) messageDocument {
+		return messageDocument{Seq: seq, Timestamp: ts}
+	}
+	tests := []struct {
+		name     string
+		docs     []messageDocument
+		sinceSeq int64
+		want     int
+	}{
+		{name: "没有消息", docs: nil, want: 0},
+		{name: "连续序列号", docs: []messageDocument{doc(1, recent), doc(2, recent), doc(3, recent)}, want: 3},
+		{name: "从 since_seq 之后连续", docs: []messageDocument{doc(6, recent), doc(7, recent)}, sinceSeq: 5, want: 2},
+		{name: "开头的新缺口", docs: []messageDocument{doc(7, recent)}, sinceSeq: 5, want: 0},
+		{name: "中间的新缺口", docs: []messageDocument{doc(1, recent), doc(2, recent), doc(4, recent)}, want: 2},
+		{name: "过期的缺口视为写入失败", docs: []messageDocument{doc(1, old), doc(3, old), doc(4, recent)}, want: 3},
+		{name: "过期缺口之后的新缺口", docs: []messageDocument{doc(1, old), doc(3, old), doc(5, recent)}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seqGapCut(tt.docs, tt.sinceSeq, now); got != tt.want {
+				t.Errorf("seqGapCut() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
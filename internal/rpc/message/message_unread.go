@@ -0,0 +1,417 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"im-service/internal/data/kafka"
+	"im-service/internal/data/redis"
+	"log"
+	"sort"
+	"time"
+)
+
+// 未读数以 conversations 集合中的 unread_count 为准，Redis 中的计数用于快速读取总未读数；
+// 查询只读 Redis，计数不存在时从 MongoDB 加载，每个用户每隔 unreadCheckInterval 在后台与 MongoDB 核对一次，
+// 不一致时以 MongoDB 为准重建 Redis；rebuild 请求则从消息本身重新统计 MongoDB 中的未读数
+
+const (
+	// unreadCheckInterval 同一用户的未读计数与会话索引核对的最小间隔
+	unreadCheckInterval = 10 * time.Minute
+	// unreadCheckTimeout 后台核对未读计数的超时时间
+	unreadCheckTimeout = 10 * time.Second
+)
+
+// notifyUnread 推送未读数变化，counts 的 key 为收到推送的用户
+func (s *CustomMessageServiceServer) notifyUnread(conversationID string, counts map[string]kafka.UnreadCount) {
+	if len(counts) == 0 {
+		return
+	}
+	if err := s.kafkaProducer.SendUnreadChange(conversationID, counts); err != nil {
+		log.Printf("发送未读数变化到 Kafka 失败: %v", err)
+	}
+}
+
+// incrementUnread 新消息写入后发送者以外的用户在会话中的未读数加一，并推送变化后的未读数
+func (s *CustomMessageServiceServer) incrementUnread(ctx context.Context, conversationID, from string, owners []string) {
+	counts := make(map[string]kafka.UnreadCount, len(owners))
+	for _, owner := range owners {
+		if owner == from {
+			continue
+		}
+		count, err := s.redisClient.IncrUnread(ctx, owner, conversationID)
+		if err != nil {
+			log.Printf("增加用户 %s 的未读数失败: %v", owner, err)
+			continue
+		}
+		if count == nil {
+			// 计数尚未建立，从会话索引加载，会话索引中已经计入了这条消息
+			if count, err = s.loadUnreadCount(ctx, owner, conversationID); err != nil {
+				log.Printf("加载用户 %s 的未读数失败: %v", owner, err)
+				continue
+			}
+		}
+		counts[owner] = kafka.UnreadCount{Conversation: count.Conversation, Total: count.Total}
+	}
+	s.notifyUnread(conversationID, counts)
+}
+
+// loadUnread 以会话索引中的未读数替换用户在 Redis 中的计数，返回各会话的未读数和总未读数
+func (s *CustomMessageServiceServer) loadUnread(ctx context.Context, username string) (map[string]int64, int64, error) {
+	counts, err := s.storedUnread(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.redisClient.ReplaceUnread(ctx, username, counts)
+	if err != nil {
+		return nil, 0, err
+	}
+	return counts, total, nil
+}
+
+// loadUnreadCount 加载用户的未读计数并返回其中一个会话的未读数
+func (s *CustomMessageServiceServer) loadUnreadCount(ctx context.Context, username, conversationID string) (*redis.UnreadCount, error) {
+	counts, total, err := s.loadUnread(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return &redis.UnreadCount{Conversation: counts[conversationID], Total: total}, nil
+}
+
+// storedUnread 返回会话索引中用户未读数大于 0 的会话
+func (s *CustomMessageServiceServer) storedUnread(ctx context.Context, username string) (map[string]int64, error) {
+	cursor, err := s.mongoClient.DB.Collection("conversations").Find(ctx,
+		bson.M{"owner": username, "unread_count": bson.M{"$gt": 0}},
+		options.Find().SetProjection(bson.M{"conversation_id": 1, "unread_count": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var docs []conversationDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(docs))
+	for _, doc := range docs {
+		counts[doc.ConversationID] = doc.UnreadCount
+	}
+	return counts, nil
+}
+
+// countUnread 根据消息统计用户在会话中的未读数
+// 单聊按消息的已读状态统计；群聊统计已读序列号之后其他成员发送的消息，从未标记已读的群会话保留会话索引中累计的未读数
+func (s *CustomMessageServiceServer) countUnread(ctx context.Context, conversation *conversationDocument) (int64, error) {
+	messagesCollection := s.mongoClient.DB.Collection("messages")
+	unexpired := s.unexpiredFilter(time.Now())
+	if peer, ok := conversationPeer(conversation.ConversationID, conversation.Owner); ok {
+		return messagesCollection.CountDocuments(ctx, bson.M{"$and": []bson.M{unexpired, {
+			"from":        peer,
+			"to":          conversation.Owner,
+			"status":      bson.M{"$ne": statusRead},
+			"deleted_for": bson.M{"$ne": conversation.Owner},
+		}}})
+	}
+	if conversation.ReadSeq == nil {
+		return conversation.UnreadCount, nil
+	}
+	return messagesCollection.CountDocuments(ctx, bson.M{"$and": []bson.M{unexpired, {
+		"conversation_id": conversation.ConversationID,
+		"seq":             bson.M{"$gt": *conversation.ReadSeq},
+		"from":            bson.M{"$ne": conversation.Owner},
+		"deleted_for":     bson.M{"$ne": conversation.Owner},
+	}}})
+}
+
+// refreshUnread 重新统计用户在会话中的未读数，写回会话索引和 Redis，有变化时推送给用户
+func (s *CustomMessageServiceServer) refreshUnread(ctx context.Context, username, conversationID string) (*redis.UnreadCount, error) {
+	conversations := s.mongoClient.DB.Collection("conversations")
+	var conversation conversationDocument
+	err := conversations.FindOne(ctx, bson.M{"owner": username, "conversation_id": conversationID}).Decode(&conversation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// 没有会话索引的会话不计未读
+		conversation = conversationDocument{Owner: username, ConversationID: conversationID}
+	} else if err != nil {
+		return nil, err
+	}
+	count, err := s.countUnread(ctx, &conversation)
+	if err != nil {
+		return nil, err
+	}
+	if count != conversation.UnreadCount {
+		_, err = conversations.UpdateOne(ctx,
+			bson.M{"owner": username, "conversation_id": conversationID},
+			bson.M{"$set": bson.M{"unread_count": count}},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unread, err := s.redisClient.SetUnread(ctx, username, conversationID, count)
+	if err != nil {
+		return nil, err
+	}
+	if unread == nil {
+		if unread, err = s.loadUnreadCount(ctx, username, conversationID); err != nil {
+			return nil, err
+		}
+	}
+	if count != conversation.UnreadCount {
+		s.notifyUnread(conversationID, map[string]kafka.UnreadCount{
+			username: {Conversation: unread.Conversation, Total: unread.Total},
+		})
+	}
+	return unread, nil
+}
+
+// rebuildUnread 根据消息重新统计用户所有会话的未读数，修正会话索引后替换 Redis 中的计数
+func (s *CustomMessageServiceServer) rebuildUnread(ctx context.Context, username string) (map[string]int64, int64, error) {
+	conversations := s.mongoClient.DB.Collection("conversations")
+	cursor, err := conversations.Find(ctx, bson.M{"owner": username},
+		options.Find().SetProjection(bson.M{"owner": 1, "conversation_id": 1, "unread_count": 1, "read_seq": 1}),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mongo.WriteModel
+	for cursor.Next(ctx) {
+		var conversation conversationDocument
+		if err := cursor.Decode(&conversation); err != nil {
+			return nil, 0, err
+		}
+		count, err := s.countUnread(ctx, &conversation)
+		if err != nil {
+			return nil, 0, err
+		}
+		if count != conversation.UnreadCount {
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"owner": username, "conversation_id": conversation.ConversationID}).
+				SetUpdate(bson.M{"$set": bson.M{"unread_count": count}}))
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, 0, err
+	}
+	if len(models) > 0 {
+		if _, err := conversations.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+			return nil, 0, err
+		}
+		log.Printf("修正用户 %s 的 %d 个会话未读数", username, len(models))
+	}
+	return s.loadUnread(ctx, username)
+}
+
+// sameUnread 判断两组未读数是否一致，未读数为 0 的会话视为不存在
+func sameUnread(a, b map[string]int64) bool {
+	nonZero := func(counts map[string]int64) int {
+		n := 0
+		for _, count := range counts {
+			if count > 0 {
+				n++
+			}
+		}
+		return n
+	}
+	if nonZero(a) != nonZero(b) {
+		return false
+	}
+	for conversationID, count := range a {
+		if count > 0 && b[conversationID] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// markP2PRead 将 peer 发给用户的消息标记为已读，直到 upTo（含），有新的已读消息时更新未读数并向 peer 推送已读回执
+func (s *CustomMessageServiceServer) markP2PRead(ctx context.Context, username, peer string, upTo *messageDocument) (*redis.UnreadCount, error) {
+	result, err := s.mongoClient.DB.Collection("messages").UpdateMany(ctx, bson.M{
+		"from":      peer,
+		"to":        username,
+		"timestamp": bson.M{"$lte": upTo.Timestamp},
+		"status":    bson.M{"$ne": statusRead},
+	}, bson.M{
+		"$set": bson.M{"status": statusRead, "read_at": time.Now()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := s.refreshUnread(ctx, username, p2pConversationID(username, peer))
+	if err != nil {
+		log.Printf("更新用户 %s 的未读数失败: %v", username, err)
+	}
+	// 没有新的已读消息时不重复推送
+	if result.ModifiedCount > 0 {
+		if err := s.kafkaProducer.SendReceipt(username, peer, kafka.ReceiptRead, nil, formatMessageID(upTo.ID)); err != nil {
+			log.Printf("发送已读回执到 Kafka 失败: %v", err)
+		}
+	}
+	return unread, nil
+}
+
+// MarkConversationRead 将会话标记为已读，直到 up_to_message_id（含）
+func (s *CustomMessageServiceServer) MarkConversationRead(ctx context.Context, req *MarkConversationReadRequest) (*MarkConversationReadResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	if err := s.checkConversationMember(ctx, req.ConversationId, req.Username); err != nil {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	upToID, err := parseMessageID(req.UpToMessageId)
+	if err != nil {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	var upTo messageDocument
+	err = s.mongoClient.DB.Collection("messages").FindOne(ctx, bson.M{"$and": []bson.M{
+		{"_id": upToID},
+		conversationMessagesFilter(req.ConversationId),
+	}}).Decode(&upTo)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: errMessageNotFound.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	var unread *redis.UnreadCount
+	if peer, ok := conversationPeer(req.ConversationId, req.Username); ok {
+		unread, err = s.markP2PRead(ctx, req.Username, peer, &upTo)
+	} else {
+		// 群消息不逐条记录已读状态，只记录用户已读到的序列号
+		_, err = s.mongoClient.DB.Collection("conversations").UpdateOne(ctx,
+			bson.M{"owner": req.Username, "conversation_id": req.ConversationId},
+			bson.M{"$max": bson.M{"read_seq": upTo.Seq}},
+		)
+		if err == nil {
+			unread, err = s.refreshUnread(ctx, req.Username, req.ConversationId)
+		}
+	}
+	if err != nil {
+		return &MarkConversationReadResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	response := &MarkConversationReadResponse{Success: true}
+	if unread != nil {
+		response.UnreadCount = unread.Conversation
+		response.TotalUnread = unread.Total
+	}
+	return response, nil
+}
+
+// GetUnreadCounts 查询用户各会话的未读数和总未读数
+// 只读取 Redis 中的计数，计数尚未建立时从会话索引加载；rebuild 为 true 时从消息重新统计
+func (s *CustomMessageServiceServer) GetUnreadCounts(ctx context.Context, req *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetUnreadCountsResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	var counts map[string]int64
+	var total int64
+	var err error
+	if req.Rebuild {
+		counts, total, err = s.rebuildUnread(ctx, req.Username)
+	} else {
+		counts, total, err = s.checkUnread(ctx, req.Username)
+	}
+	if err != nil {
+		return &GetUnreadCountsResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	conversations := make([]*ConversationUnread, 0, len(counts))
+	for conversationID, count := range counts {
+		if count > 0 {
+			conversations = append(conversations, &ConversationUnread{ConversationId: conversationID, UnreadCount: count})
+		}
+	}
+	sort.Slice(conversations, func(i, j int) bool {
+		return conversations[i].ConversationId < conversations[j].ConversationId
+	})
+
+	return &GetUnreadCountsResponse{
+		Success:       true,
+		TotalUnread:   total,
+		Conversations: conversations,
+	}, nil
+}
+
+// checkUnread 读取用户在 Redis 中的未读计数，尚未建立时从会话索引加载
+// 距上次核对超过 unreadCheckInterval 时在后台与会话索引核对，不阻塞本次查询
+func (s *CustomMessageServiceServer) checkUnread(ctx context.Context, username string) (map[string]int64, int64, error) {
+	counts, total, ok, err := s.redisClient.GetUnread(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ok {
+		return s.loadUnread(ctx, username)
+	}
+	due, err := s.redisClient.ClaimUnreadCheck(ctx, username, unreadCheckInterval)
+	if err != nil {
+		log.Printf("领取用户 %s 的未读数核对失败: %v", username, err)
+	} else if due {
+		go s.reconcileUnread(username)
+	}
+	return counts, total, nil
+}
+
+// reconcileUnread 核对用户在 Redis 中的未读计数与会话索引，不一致时以会话索引重建
+func (s *CustomMessageServiceServer) reconcileUnread(username string) {
+	ctx, cancel := context.WithTimeout(context.Background(), unreadCheckTimeout)
+	defer cancel()
+	stored, err := s.storedUnread(ctx, username)
+	if err != nil {
+		log.Printf("查询用户 %s 的会话未读数失败: %v", username, err)
+		return
+	}
+	counts, total, ok, err := s.redisClient.GetUnread(ctx, username)
+	if err != nil {
+		log.Printf("读取用户 %s 的未读计数失败: %v", username, err)
+		return
+	}
+	var storedTotal int64
+	for _, count := range stored {
+		storedTotal += count
+	}
+	if ok && total == storedTotal && sameUnread(counts, stored) {
+		return
+	}
+	log.Printf("用户 %s 的未读计数与会话索引不一致，重新加载", username)
+	if _, err := s.redisClient.ReplaceUnread(ctx, username, stored); err != nil {
+		log.Printf("重建用户 %s 的未读计数失败: %v", username, err)
+	}
+}
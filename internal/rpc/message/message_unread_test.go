@@ -0,0 +1,64 @@
+package message
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"im-service/internal/data/redis"
+	"testing"
+	"time"
+)
+
+func TestGetUnreadCountsReadsRedisOnly(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisClient := redis.NewRedisClient(mr.Addr(), "")
+	// 没有 MongoDB 客户端，查询一旦访问 MongoDB 就会失败
+	s := &CustomMessageServiceServer{redisClient: redisClient}
+	ctx := context.WithValue(context.Background(), "username", "alice")
+
+	if _, err := redisClient.ReplaceUnread(ctx, "alice", map[string]int64{"p2p:alice:bob": 2, "group:g1": 3}); err != nil {
+		t.Fatal(err)
+	}
+	// 最近已经核对过，不会在后台访问 MongoDB
+	if _, err := redisClient.ClaimUnreadCheck(ctx, "alice", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := s.GetUnreadCounts(ctx, &GetUnreadCountsRequest{Username: "alice"})
+		if err != nil {
+			t.Fatalf("GetUnreadCounts() error = %v", err)
+		}
+		if !resp.Success || resp.TotalUnread != 5 || len(resp.Conversations) != 2 {
+			t.Fatalf("GetUnreadCounts() = %+v, want total 5 in 2 conversations", resp)
+		}
+		if resp.Conversations[0].ConversationId != "group:g1" || resp.Conversations[0].UnreadCount != 3 {
+			t.Fatalf("Conversations[0] = %+v", resp.Conversations[0])
+		}
+	}
+}
+
+func TestClaimUnreadCheck(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisClient := redis.NewRedisClient(mr.Addr(), "")
+	ctx := context.Background()
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		want    bool
+	}{
+		{name: "第一次查询需要核对", want: true},
+		{name: "间隔内不再核对", advance: 5 * time.Minute, want: false},
+		{name: "间隔过后重新核对", advance: unreadCheckInterval, want: true},
+	}
+	for _, step := range steps {
+		mr.FastForward(step.advance)
+		got, err := redisClient.ClaimUnreadCheck(ctx, "alice", unreadCheckInterval)
+		if err != nil {
+			t.Fatalf("%s: ClaimUnreadCheck() error = %v", step.name, err)
+		}
+		if got != step.want {
+			t.Fatalf("%s: ClaimUnreadCheck() = %v, want %v", step.name, got, step.want)
+		}
+	}
+}
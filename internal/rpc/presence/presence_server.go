@@ -0,0 +1,212 @@
+package presence
+
+import (
+	"context"
+	"im-service/internal/data/kafka"
+	"im-service/internal/data/mongodb"
+	"im-service/internal/data/redis"
+	"im-service/internal/rpc/friend"
+	"log"
+)
+
+// maxPresenceQuery 单次最多查询的用户数
+const maxPresenceQuery = 200
+
+// CustomPresenceServiceServer 实现 PresenceService 服务
+type CustomPresenceServiceServer struct {
+	UnimplementedPresenceServiceServer
+	kafkaProducer *kafka.KafkaProducer
+	mongoClient   *mongodb.MongoClient
+	redisClient   *redis.RedisClient
+}
+
+// NewCustomPresenceServiceServer 创建在线状态服务端实例
+func NewCustomPresenceServiceServer(kafkaProducer *kafka.KafkaProducer, mongoClient *mongodb.MongoClient, redisClient *redis.RedisClient) *CustomPresenceServiceServer {
+	return &CustomPresenceServiceServer{
+		kafkaProducer: kafkaProducer,
+		mongoClient:   mongoClient,
+		redisClient:   redisClient,
+	}
+}
+
+// toRedisStatus 将接口中的状态转换为 Redis 中存储的状态
+func toRedisStatus(status PresenceStatus) string {
+	switch status {
+	case PresenceStatus_ONLINE:
+		return redis.PresenceOnline
+	case PresenceStatus_AWAY:
+		return redis.PresenceAway
+	default:
+		return redis.PresenceOffline
+	}
+}
+
+// toPresenceStatus 将 Redis 中存储的状态转换为接口中的状态
+func toPresenceStatus(status string) PresenceStatus {
+	switch status {
+	case redis.PresenceOnline:
+		return PresenceStatus_ONLINE
+	case redis.PresenceAway:
+		return PresenceStatus_AWAY
+	default:
+		return PresenceStatus_OFFLINE
+	}
+}
+
+// SetDeviceStatus 更新设备的在线状态，用户的聚合状态发生变化时通知好友
+func (s *CustomPresenceServiceServer) SetDeviceStatus(ctx context.Context, req *SetDeviceStatusRequest) (*SetDeviceStatusResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SetDeviceStatusResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.DeviceId == "" {
+		return &SetDeviceStatusResponse{
+			Success:  false,
+			ErrorMsg: "设备 ID 不能为空",
+		}, nil
+	}
+
+	// 前后的聚合状态与更新在同一脚本中计算，并发更新时不会误判状态变化
+	before, after, err := s.redisClient.SetDevicePresence(ctx, req.Username, req.DeviceId, req.NodeId, toRedisStatus(req.Status))
+	if err != nil {
+		return &SetDeviceStatusResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	if before != after {
+		hidden, err := s.redisClient.IsPresenceHidden(ctx, req.Username)
+		if err != nil {
+			log.Printf("查询用户 %s 是否隐藏在线状态失败: %v", req.Username, err)
+		}
+		// 隐藏在线状态的用户对好友始终是离线，状态变化不再推送
+		if err == nil && !hidden {
+			s.notifyFriends(ctx, req.Username, after)
+		}
+	}
+
+	return &SetDeviceStatusResponse{
+		Success: true,
+		Status:  toPresenceStatus(after),
+	}, nil
+}
+
+// SetPresenceHidden 隐藏或公开在线状态，好友会收到相应的状态变化
+func (s *CustomPresenceServiceServer) SetPresenceHidden(ctx context.Context, req *SetPresenceHiddenRequest) (*SetPresenceHiddenResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &SetPresenceHiddenResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	wasHidden, err := s.redisClient.IsPresenceHidden(ctx, req.Username)
+	if err != nil {
+		return &SetPresenceHiddenResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	if err := s.redisClient.SetPresenceHidden(ctx, req.Username, req.Hidden); err != nil {
+		return &SetPresenceHiddenResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	// 用户当前不在线时，隐藏与否对好友看到的状态没有影响
+	if wasHidden != req.Hidden {
+		status, err := s.redisClient.GetPresence(ctx, req.Username)
+		if err != nil {
+			log.Printf("查询用户 %s 在线状态失败: %v", req.Username, err)
+		} else if status != redis.PresenceOffline {
+			if req.Hidden {
+				status = redis.PresenceOffline
+			}
+			s.notifyFriends(ctx, req.Username, status)
+		}
+	}
+
+	return &SetPresenceHiddenResponse{Success: true}, nil
+}
+
+// GetPresence 查询用户自己和好友的在线状态，非好友和隐藏在线状态的用户一律显示为离线
+func (s *CustomPresenceServiceServer) GetPresence(ctx context.Context, req *GetPresenceRequest) (*GetPresenceResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetPresenceResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if len(req.Usernames) > maxPresenceQuery {
+		return &GetPresenceResponse{
+			Success:  false,
+			ErrorMsg: "查询的用户过多",
+		}, nil
+	}
+
+	friends, err := friend.ListFriends(ctx, s.mongoClient, s.redisClient, req.Username)
+	if err != nil {
+		return &GetPresenceResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	visible := map[string]bool{req.Username: true}
+	for _, name := range friends {
+		visible[name] = true
+	}
+
+	presences := make([]*UserPresence, 0, len(req.Usernames))
+	for _, name := range req.Usernames {
+		presence := &UserPresence{Username: name, Status: PresenceStatus_OFFLINE}
+		presences = append(presences, presence)
+		if !visible[name] {
+			continue
+		}
+		if name != req.Username {
+			hidden, err := s.redisClient.IsPresenceHidden(ctx, name)
+			if err != nil || hidden {
+				continue
+			}
+		}
+		status, err := s.redisClient.GetPresence(ctx, name)
+		if err != nil {
+			log.Printf("查询用户 %s 在线状态失败: %v", name, err)
+			continue
+		}
+		presence.Status = toPresenceStatus(status)
+		if presence.LastSeen, err = s.redisClient.GetLastSeen(ctx, name); err != nil {
+			log.Printf("查询用户 %s 最后在线时间失败: %v", name, err)
+		}
+	}
+
+	return &GetPresenceResponse{
+		Presences: presences,
+		Success:   true,
+	}, nil
+}
+
+// notifyFriends 向用户的所有好友推送在线状态变化
+func (s *CustomPresenceServiceServer) notifyFriends(ctx context.Context, userName, status string) {
+	friends, err := friend.ListFriends(ctx, s.mongoClient, s.redisClient, userName)
+	if err != nil {
+		log.Printf("查询用户 %s 的好友失败: %v", userName, err)
+		return
+	}
+	if err := s.kafkaProducer.SendPresenceChange(userName, status, friends); err != nil {
+		log.Printf("发送在线状态变化到 Kafka 失败: %v", err)
+	}
+}
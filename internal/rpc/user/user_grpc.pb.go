@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName = "/user.UserService/Register"
-	UserService_Login_FullMethodName    = "/user.UserService/Login"
+	UserService_Register_FullMethodName         = "/user.UserService/Register"
+	UserService_Login_FullMethodName            = "/user.UserService/Login"
+	UserService_UploadDeviceKeys_FullMethodName = "/user.UserService/UploadDeviceKeys"
+	UserService_GetIdentityKeys_FullMethodName  = "/user.UserService/GetIdentityKeys"
+	UserService_GetPrekeyBundles_FullMethodName = "/user.UserService/GetPrekeyBundles"
+	UserService_GetPrekeyCount_FullMethodName   = "/user.UserService/GetPrekeyCount"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,6 +35,14 @@ const (
 type UserServiceClient interface {
 	Register(ctx context.Context, in *UserRegisterRequest, opts ...grpc.CallOption) (*UserRegisterResponse, error)
 	Login(ctx context.Context, in *UserLoginRequest, opts ...grpc.CallOption) (*UserLoginResponse, error)
+	// 上传设备的身份公钥、签名预共享公钥和一次性预共享公钥
+	UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysRequest, opts ...grpc.CallOption) (*UploadDeviceKeysResponse, error)
+	// 查询用户各设备的身份公钥
+	GetIdentityKeys(ctx context.Context, in *GetIdentityKeysRequest, opts ...grpc.CallOption) (*GetIdentityKeysResponse, error)
+	// 获取用户各设备的预共享公钥包
+	GetPrekeyBundles(ctx context.Context, in *GetPrekeyBundlesRequest, opts ...grpc.CallOption) (*GetPrekeyBundlesResponse, error)
+	// 查询设备剩余的一次性预共享公钥数
+	GetPrekeyCount(ctx context.Context, in *GetPrekeyCountRequest, opts ...grpc.CallOption) (*GetPrekeyCountResponse, error)
 }
 
 type userServiceClient struct {
@@ -61,6 +73,46 @@ func (c *userServiceClient) Login(ctx context.Context, in *UserLoginRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) UploadDeviceKeys(ctx context.Context, in *UploadDeviceKeysRequest, opts ...grpc.CallOption) (*UploadDeviceKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadDeviceKeysResponse)
+	err := c.cc.Invoke(ctx, UserService_UploadDeviceKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetIdentityKeys(ctx context.Context, in *GetIdentityKeysRequest, opts ...grpc.CallOption) (*GetIdentityKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentityKeysResponse)
+	err := c.cc.Invoke(ctx, UserService_GetIdentityKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPrekeyBundles(ctx context.Context, in *GetPrekeyBundlesRequest, opts ...grpc.CallOption) (*GetPrekeyBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrekeyBundlesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPrekeyBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPrekeyCount(ctx context.Context, in *GetPrekeyCountRequest, opts ...grpc.CallOption) (*GetPrekeyCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrekeyCountResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPrekeyCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -69,6 +121,14 @@ func (c *userServiceClient) Login(ctx context.Context, in *UserLoginRequest, opt
 type UserServiceServer interface {
 	Register(context.Context, *UserRegisterRequest) (*UserRegisterResponse, error)
 	Login(context.Context, *UserLoginRequest) (*UserLoginResponse, error)
+	// 上传设备的身份公钥、签名预共享公钥和一次性预共享公钥
+	UploadDeviceKeys(context.Context, *UploadDeviceKeysRequest) (*UploadDeviceKeysResponse, error)
+	// 查询用户各设备的身份公钥
+	GetIdentityKeys(context.Context, *GetIdentityKeysRequest) (*GetIdentityKeysResponse, error)
+	// 获取用户各设备的预共享公钥包
+	GetPrekeyBundles(context.Context, *GetPrekeyBundlesRequest) (*GetPrekeyBundlesResponse, error)
+	// 查询设备剩余的一次性预共享公钥数
+	GetPrekeyCount(context.Context, *GetPrekeyCountRequest) (*GetPrekeyCountResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -85,6 +145,18 @@ func (UnimplementedUserServiceServer) Register(context.Context, *UserRegisterReq
 func (UnimplementedUserServiceServer) Login(context.Context, *UserLoginRequest) (*UserLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedUserServiceServer) UploadDeviceKeys(context.Context, *UploadDeviceKeysRequest) (*UploadDeviceKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDeviceKeys not implemented")
+}
+func (UnimplementedUserServiceServer) GetIdentityKeys(context.Context, *GetIdentityKeysRequest) (*GetIdentityKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityKeys not implemented")
+}
+func (UnimplementedUserServiceServer) GetPrekeyBundles(context.Context, *GetPrekeyBundlesRequest) (*GetPrekeyBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrekeyBundles not implemented")
+}
+func (UnimplementedUserServiceServer) GetPrekeyCount(context.Context, *GetPrekeyCountRequest) (*GetPrekeyCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrekeyCount not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -142,6 +214,78 @@ func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UploadDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDeviceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UploadDeviceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadDeviceKeys(ctx, req.(*UploadDeviceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetIdentityKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetIdentityKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetIdentityKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetIdentityKeys(ctx, req.(*GetIdentityKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPrekeyBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrekeyBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrekeyBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrekeyBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrekeyBundles(ctx, req.(*GetPrekeyBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPrekeyCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrekeyCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrekeyCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrekeyCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrekeyCount(ctx, req.(*GetPrekeyCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +301,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _UserService_Login_Handler,
 		},
+		{
+			MethodName: "UploadDeviceKeys",
+			Handler:    _UserService_UploadDeviceKeys_Handler,
+		},
+		{
+			MethodName: "GetIdentityKeys",
+			Handler:    _UserService_GetIdentityKeys_Handler,
+		},
+		{
+			MethodName: "GetPrekeyBundles",
+			Handler:    _UserService_GetPrekeyBundles_Handler,
+		},
+		{
+			MethodName: "GetPrekeyCount",
+			Handler:    _UserService_GetPrekeyCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/rpc/user/user.proto",
@@ -0,0 +1,379 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"im-service/internal/data/mysql"
+	"im-service/internal/rpc/friend"
+	"log"
+	"time"
+)
+
+const (
+	// maxDeviceIDLength 设备 ID 的最大长度
+	maxDeviceIDLength = 64
+	// maxPublicKeySize 公钥和签名的最大字节数
+	maxPublicKeySize = 256
+	// maxPrekeysPerUpload 每次最多上传的一次性预共享公钥数
+	maxPrekeysPerUpload = 100
+	// maxPrekeysPerDevice 每个设备最多保存的一次性预共享公钥数
+	maxPrekeysPerDevice = 500
+	// lowPrekeyThreshold 剩余的一次性预共享公钥少于该值时提醒设备补充
+	lowPrekeyThreshold = 10
+	// prekeyClaimLimit 同一调用者在一个窗口内最多获取同一用户预共享公钥包的次数，防止耗尽对方的一次性预共享公钥
+	prekeyClaimLimit = 10
+	// prekeyClaimWindow 获取预共享公钥包的限流窗口
+	prekeyClaimWindow = time.Hour
+)
+
+var (
+	errInvalidDeviceID   = errors.New("无效的设备 ID")
+	errInvalidKey        = errors.New("无效的公钥")
+	errTooManyPrekeys    = errors.New("一次性预共享公钥数量超过上限")
+	errNotFriends        = errors.New("对方不是你的好友，无法获取预共享公钥")
+	errPrekeyRateLimited = errors.New("获取预共享公钥过于频繁，请稍后再试")
+)
+
+// validKey 校验客户端上传的公钥或签名，服务端不解析其格式
+func validKey(key []byte) bool {
+	return len(key) > 0 && len(key) <= maxPublicKeySize
+}
+
+// validateDeviceKeys 校验上传的设备公钥
+func validateDeviceKeys(req *UploadDeviceKeysRequest) error {
+	if req.DeviceId == "" || len(req.DeviceId) > maxDeviceIDLength {
+		return errInvalidDeviceID
+	}
+	if !validKey(req.IdentityKey) || req.SignedPrekey == nil ||
+		!validKey(req.SignedPrekey.PublicKey) || !validKey(req.SignedPrekey.Signature) {
+		return errInvalidKey
+	}
+	if len(req.OneTimePrekeys) > maxPrekeysPerUpload {
+		return errTooManyPrekeys
+	}
+	for _, prekey := range req.OneTimePrekeys {
+		if !validKey(prekey.PublicKey) {
+			return errInvalidKey
+		}
+	}
+	return nil
+}
+
+// countPrekeys 返回设备剩余的一次性预共享公钥数
+func countPrekeys(db *gorm.DB, username, deviceID string) (int64, error) {
+	var count int64
+	err := db.Model(&mysql.OneTimePrekey{}).Where("username = ? AND device_id = ?", username, deviceID).Count(&count).Error
+	return count, err
+}
+
+// UploadDeviceKeys 上传设备的身份公钥、签名预共享公钥和一次性预共享公钥
+func (s *CustomUserServiceServer) UploadDeviceKeys(ctx context.Context, req *UploadDeviceKeysRequest) (*UploadDeviceKeysResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &UploadDeviceKeysResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if err := validateDeviceKeys(req); err != nil {
+		return &UploadDeviceKeysResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	var remaining int64
+	err := s.mysqlClient.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing mysql.DeviceKey
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("username = ? AND device_id = ?", req.Username, req.DeviceId).
+			First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+		case err != nil:
+			return err
+		case !bytes.Equal(existing.IdentityKey, req.IdentityKey):
+			// 身份公钥变化说明设备重新生成了密钥，旧的一次性预共享公钥已无法使用
+			err := tx.Where("username = ? AND device_id = ?", req.Username, req.DeviceId).
+				Delete(&mysql.OneTimePrekey{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "username"}, {Name: "device_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"registration_id", "identity_key", "signed_prekey_id", "signed_prekey", "signed_prekey_signature", "updated_at",
+			}),
+		}).Create(&mysql.DeviceKey{
+			Username:              req.Username,
+			DeviceID:              req.DeviceId,
+			RegistrationID:        req.RegistrationId,
+			IdentityKey:           req.IdentityKey,
+			SignedPrekeyID:        req.SignedPrekey.KeyId,
+			SignedPrekey:          req.SignedPrekey.PublicKey,
+			SignedPrekeySignature: req.SignedPrekey.Signature,
+			UpdatedAt:             time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		if remaining, err = countPrekeys(tx, req.Username, req.DeviceId); err != nil {
+			return err
+		}
+		if len(req.OneTimePrekeys) == 0 {
+			return nil
+		}
+		if remaining+int64(len(req.OneTimePrekeys)) > maxPrekeysPerDevice {
+			return errTooManyPrekeys
+		}
+		prekeys := make([]mysql.OneTimePrekey, 0, len(req.OneTimePrekeys))
+		for _, prekey := range req.OneTimePrekeys {
+			prekeys = append(prekeys, mysql.OneTimePrekey{
+				Username:  req.Username,
+				DeviceID:  req.DeviceId,
+				KeyID:     prekey.KeyId,
+				PublicKey: prekey.PublicKey,
+			})
+		}
+		// 重试上传时 key_id 已存在的公钥忽略
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&prekeys).Error; err != nil {
+			return err
+		}
+		remaining, err = countPrekeys(tx, req.Username, req.DeviceId)
+		return err
+	})
+	if err != nil {
+		return &UploadDeviceKeysResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	if remaining >= lowPrekeyThreshold {
+		// 补充到阈值以上，之后再次不足时重新提醒
+		if err := s.redisClient.ClearPrekeysLow(ctx, req.Username, req.DeviceId); err != nil {
+			log.Printf("清除设备 %s 的预共享公钥不足标记失败: %v", req.DeviceId, err)
+		}
+	}
+
+	return &UploadDeviceKeysResponse{
+		Success:          true,
+		RemainingPrekeys: remaining,
+		PrekeysLow:       remaining < lowPrekeyThreshold,
+	}, nil
+}
+
+// GetIdentityKeys 查询用户各设备的身份公钥，用于核对安全码，不消耗预共享公钥
+func (s *CustomUserServiceServer) GetIdentityKeys(ctx context.Context, req *GetIdentityKeysRequest) (*GetIdentityKeysResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetIdentityKeysResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+
+	var keys []mysql.DeviceKey
+	err := s.mysqlClient.DB.WithContext(ctx).Where("username = ?", req.Target).Order("device_id").Find(&keys).Error
+	if err != nil {
+		return &GetIdentityKeysResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	devices := make([]*DeviceIdentityKey, 0, len(keys))
+	for _, key := range keys {
+		devices = append(devices, &DeviceIdentityKey{
+			DeviceId:       key.DeviceID,
+			RegistrationId: key.RegistrationID,
+			IdentityKey:    key.IdentityKey,
+			UpdatedAt:      key.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &GetIdentityKeysResponse{
+		Success: true,
+		Devices: devices,
+	}, nil
+}
+
+// GetPrekeyBundles 获取用户各设备的预共享公钥包，每个设备取走一个一次性预共享公钥
+// 只能获取自己其他设备或未互相拉黑的好友的公钥包，且按调用者和目标用户限流；
+// 一次性预共享公钥耗尽时只返回签名预共享公钥；剩余数量低于阈值时提醒该用户的设备补充，补充前只提醒一次
+func (s *CustomUserServiceServer) GetPrekeyBundles(ctx context.Context, req *GetPrekeyBundlesRequest) (*GetPrekeyBundlesResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetPrekeyBundlesResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if err := s.checkPrekeyClaim(ctx, req.Username, req.Target); err != nil {
+		return &GetPrekeyBundlesResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	query := s.mysqlClient.DB.WithContext(ctx).Where("username = ?", req.Target)
+	if req.DeviceId != "" {
+		query = query.Where("device_id = ?", req.DeviceId)
+	}
+	var keys []mysql.DeviceKey
+	if err := query.Order("device_id").Find(&keys).Error; err != nil {
+		return &GetPrekeyBundlesResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+
+	bundles := make([]*PrekeyBundle, 0, len(keys))
+	for _, key := range keys {
+		bundle := &PrekeyBundle{
+			DeviceId:       key.DeviceID,
+			RegistrationId: key.RegistrationID,
+			IdentityKey:    key.IdentityKey,
+			SignedPrekey: &SignedPrekey{
+				KeyId:     key.SignedPrekeyID,
+				PublicKey: key.SignedPrekey,
+				Signature: key.SignedPrekeySignature,
+			},
+		}
+		prekey, remaining, err := s.claimPrekey(ctx, key.Username, key.DeviceID)
+		if err != nil {
+			return &GetPrekeyBundlesResponse{
+				Success:  false,
+				ErrorMsg: err.Error(),
+			}, nil
+		}
+		if prekey != nil {
+			bundle.OneTimePrekey = &OneTimePrekey{KeyId: prekey.KeyID, PublicKey: prekey.PublicKey}
+		}
+		if remaining < lowPrekeyThreshold {
+			s.warnPrekeysLow(ctx, key.Username, key.DeviceID, remaining)
+		}
+		bundles = append(bundles, bundle)
+	}
+
+	return &GetPrekeyBundlesResponse{
+		Success: true,
+		Bundles: bundles,
+	}, nil
+}
+
+// checkPrekeyClaim 检查调用者是否可以获取目标用户的预共享公钥包：自己的其他设备不受限制，
+// 其他用户必须是未互相拉黑的好友，并且同一调用者对同一目标用户的获取次数受限
+func (s *CustomUserServiceServer) checkPrekeyClaim(ctx context.Context, caller, target string) error {
+	if caller == target {
+		return nil
+	}
+	isFriend, err := friend.IsFriends(ctx, s.mongoClient, s.redisClient, caller, target)
+	if err != nil {
+		return err
+	}
+	if !isFriend {
+		return errNotFriends
+	}
+	// 被对方拉黑时返回通用错误，不透露拉黑状态
+	if err := friend.CheckBlocked(ctx, s.mongoClient, caller, target); err != nil {
+		return err
+	}
+	allowed, err := s.redisClient.AllowRate(ctx, "prekey:"+caller+":"+target, prekeyClaimLimit, prekeyClaimWindow)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errPrekeyRateLimited
+	}
+	return nil
+}
+
+// warnPrekeysLow 提醒设备补充一次性预共享公钥，低于阈值后只提醒一次，设备补充后才会再次提醒
+func (s *CustomUserServiceServer) warnPrekeysLow(ctx context.Context, username, deviceID string, remaining int64) {
+	first, err := s.redisClient.MarkPrekeysLow(ctx, username, deviceID)
+	if err != nil {
+		log.Printf("记录设备 %s 的预共享公钥不足标记失败: %v", deviceID, err)
+		return
+	}
+	if !first {
+		return
+	}
+	if err := s.kafkaProducer.SendPrekeysLow(username, deviceID, remaining); err != nil {
+		log.Printf("发送预共享公钥不足事件到 Kafka 失败: %v", err)
+		// 未能提醒时清除标记，下次被取走时重试
+		if err := s.redisClient.ClearPrekeysLow(ctx, username, deviceID); err != nil {
+			log.Printf("清除设备 %s 的预共享公钥不足标记失败: %v", deviceID, err)
+		}
+	}
+}
+
+// claimPrekey 取走设备最早上传的一次性预共享公钥，返回取走的公钥（已耗尽时为 nil）和剩余数量
+func (s *CustomUserServiceServer) claimPrekey(ctx context.Context, username, deviceID string) (*mysql.OneTimePrekey, int64, error) {
+	var claimed *mysql.OneTimePrekey
+	var remaining int64
+	err := s.mysqlClient.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var prekey mysql.OneTimePrekey
+		// 加锁保证同一个公钥只会被一个请求取走
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("username = ? AND device_id = ?", username, deviceID).
+			Order("id").
+			First(&prekey).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(&prekey).Error; err != nil {
+			return err
+		}
+		claimed = &prekey
+		remaining, err = countPrekeys(tx, username, deviceID)
+		return err
+	})
+	return claimed, remaining, err
+}
+
+// GetPrekeyCount 查询设备剩余的一次性预共享公钥数
+func (s *CustomUserServiceServer) GetPrekeyCount(ctx context.Context, req *GetPrekeyCountRequest) (*GetPrekeyCountResponse, error) {
+	//从上下文中获取用户名
+	username := ctx.Value("username")
+	//验证用户
+	if username != req.Username {
+		return &GetPrekeyCountResponse{
+			Success:  false,
+			ErrorMsg: "你不是用户本人",
+		}, nil
+	}
+	if req.DeviceId == "" {
+		return &GetPrekeyCountResponse{
+			Success:  false,
+			ErrorMsg: errInvalidDeviceID.Error(),
+		}, nil
+	}
+
+	remaining, err := countPrekeys(s.mysqlClient.DB.WithContext(ctx), req.Username, req.DeviceId)
+	if err != nil {
+		return &GetPrekeyCountResponse{
+			Success:  false,
+			ErrorMsg: err.Error(),
+		}, nil
+	}
+	return &GetPrekeyCountResponse{
+		Success:          true,
+		RemainingPrekeys: remaining,
+		PrekeysLow:       remaining < lowPrekeyThreshold,
+	}, nil
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
 	"gorm.io/gorm"
+	"im-service/internal/data/kafka"
+	"im-service/internal/data/mongodb"
 	"im-service/internal/data/mysql"
 	"im-service/internal/data/redis"
 	"im-service/internal/general"
@@ -16,15 +18,19 @@ import (
 // CustomUserServiceServer 实现 UserService 服务
 type CustomUserServiceServer struct {
 	UnimplementedUserServiceServer
-	mysqlClient *mysql.MySQLClient
-	redisClient *redis.RedisClient
+	mysqlClient   *mysql.MySQLClient
+	redisClient   *redis.RedisClient
+	mongoClient   *mongodb.MongoClient
+	kafkaProducer *kafka.KafkaProducer
 }
 
 // NewCustomUserServiceServer 创建用户服务端实例
-func NewCustomUserServiceServer(mysqlClient *mysql.MySQLClient, redisClient *redis.RedisClient) *CustomUserServiceServer {
+func NewCustomUserServiceServer(mysqlClient *mysql.MySQLClient, redisClient *redis.RedisClient, mongoClient *mongodb.MongoClient, kafkaProducer *kafka.KafkaProducer) *CustomUserServiceServer {
 	return &CustomUserServiceServer{
-		mysqlClient: mysqlClient,
-		redisClient: redisClient,
+		mysqlClient:   mysqlClient,
+		redisClient:   redisClient,
+		mongoClient:   mongoClient,
+		kafkaProducer: kafkaProducer,
 	}
 }
 
@@ -3,65 +3,43 @@ package start
 import (
 	"context"
 	"github.com/gorilla/websocket"
-	"google.golang.org/grpc/metadata"
-	"im-service/config"
-	general2 "im-service/internal/general"
+	"im-service/internal/general"
 	"im-service/internal/handler"
-	"im-service/internal/loadmonitor"
-	"im-service/internal/rpc/friend"
-	"im-service/internal/rpc/message"
-	"im-service/internal/rpc/user"
+	"im-service/internal/middleware"
+	"im-service/internal/protocol"
+	"im-service/internal/svc"
+	websocket2 "im-service/internal/websocket"
 	"log"
 	"net/http"
+	"strings"
 )
 
 // UpGrader 定义升级器
 var UpGrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	// 客户端通过子协议选择 JSON 或 protobuf 帧编码
+	Subprotocols: protocol.Subprotocols,
 	CheckOrigin: func(r *http.Request) bool {
 		return true
 	},
 }
 
-// WsHandler 处理 WebSocket 连接
-func WsHandler(cfg config.Config, lm *loadmonitor.LoadMonitor, w http.ResponseWriter, r *http.Request) {
-	// 从请求头中获取 token
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		http.Error(w, "缺少 Authorization 头", http.StatusUnauthorized)
-		return
-	}
-
-	// 将 token 添加到 gRPC 上下文中
-	md := metadata.New(map[string]string{"authorization": token})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	//// 创建一个无超时的上下文
-	//ctx := context.Background()
-
-	// 建立与用户服务的 gRPC 连接
-	userConn, err := general2.CreateGRPCConnection(cfg.UserRpc.Endpoints, lm)
-	if err != nil {
-		log.Fatalf("无法连接到用户服务: %v", err)
-	}
-	defer userConn.Close()
-	userClient := user.NewUserServiceClient(userConn)
-
-	// 建立与消息服务的 gRPC 连接
-	messageConn, err := general2.CreateGRPCConnection(cfg.MessageRpc.Endpoints, lm)
-	if err != nil {
-		log.Fatalf("无法连接到消息服务: %v", err)
+// WsHandler 处理 WebSocket 连接，后端服务的 gRPC 客户端由 ServiceContext 在进程内共享
+func WsHandler(sc *svc.ServiceContext, w http.ResponseWriter, r *http.Request) {
+	// Authorization 头可选：携带时在升级前校验，升级后连接直接处于已认证状态；
+	// 不携带时连接保持未认证，需通过 login 或 auth 命令认证
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	var userName string
+	if token != "" {
+		var err error
+		userName, err = middleware.ValidateToken(token)
+		if err != nil {
+			http.Error(w, "无效的 Authorization 头", http.StatusUnauthorized)
+			return
+		}
 	}
-	defer messageConn.Close()
-	messageClient := message.NewMessageServiceClient(messageConn)
-
-	//建立与好友服务的 gRPC 连接
-	friendConn, err := general2.CreateGRPCConnection(cfg.FriendRpc.Endpoints, lm)
-	if err != nil {
-		log.Printf("无法连接到好友服务: %v", err)
-	}
-	defer friendConn.Close()
-	friendClient := friend.NewFriendServiceClient(friendConn)
+	ctx := context.Background()
 
 	//WebSocket 连接升级
 	conn, err := UpGrader.Upgrade(w, r, nil)
@@ -70,13 +48,29 @@ func WsHandler(cfg config.Config, lm *loadmonitor.LoadMonitor, w http.ResponseWr
 		return
 	}
 
+	// 每个连接拥有独立的写协程，所有写操作串行执行
+	wsConn := websocket2.NewWebSocketConnection(conn, protocol.CodecForSubprotocol(conn.Subprotocol()))
+	// 通过 Authorization 头认证时，设备 ID 由 X-Device-Id 头或 device_id 查询参数提供
+	deviceID := r.Header.Get("X-Device-Id")
+	if deviceID == "" {
+		deviceID = r.URL.Query().Get("device_id")
+	}
+	wsConn.SetDeviceID(deviceID)
+	if userName != "" {
+		handler.BindSession(ctx, sc, wsConn, userName, token)
+	}
+
 	//连接成功建立后，启动心跳机制
-	go general2.SendHeartBeat(conn)
+	go general.SendHeartBeat(wsConn)
+	// token 过期且未刷新的连接由网关主动关闭
+	go handler.WatchSessionExpiry(wsConn)
 
-	//监听心跳机制
-	go general2.ListenForMessages(conn)
+	// 通过 Authorization 头认证的连接立即补推离线消息
+	if userName != "" {
+		handler.DeliverOfflineMessages(ctx, sc, wsConn)
+	}
 
-	// 启动读取客户端消息的循环
-	handler.ReadClientMessages(ctx, conn, userClient, messageClient, friendClient)
+	// 启动读取客户端消息的循环，心跳超时由读循环检测
+	handler.ReadClientMessages(ctx, sc, wsConn)
 
 }
@@ -1,11 +1,21 @@
 package svc
 
 import (
+	"fmt"
 	"im-service/config"
 	"im-service/internal/data/kafka"
 	"im-service/internal/data/mongodb"
 	"im-service/internal/data/mysql"
 	"im-service/internal/data/redis"
+	"im-service/internal/data/storage"
+	"im-service/internal/general"
+	"im-service/internal/loadmonitor"
+	"im-service/internal/rpc/attachment"
+	"im-service/internal/rpc/friend"
+	"im-service/internal/rpc/group"
+	"im-service/internal/rpc/message"
+	"im-service/internal/rpc/presence"
+	"im-service/internal/rpc/user"
 )
 
 // ContextKey 用于存储 ServiceContext 的键
@@ -19,15 +29,75 @@ type ServiceContext struct {
 	MySQLClient   *mysql.MySQLClient
 	RedisClient   *redis.RedisClient
 	KafkaConsumer *kafka.KafkaConsumer
+	// NodeConsumer 消费当前网关节点专属 topic
+	NodeConsumer *kafka.KafkaConsumer
+	Router       *kafka.Router
+	// IDGenerator 消息 ID 生成器，进程内的消息服务共享
+	IDGenerator *general.Snowflake
+	// Storage 附件内容的存储后端
+	Storage storage.Storage
+
+	// 进程内共享的 gRPC 客户端，由 InitRpcClients 创建
+	UserClient       user.UserServiceClient
+	MessageClient    message.MessageServiceClient
+	FriendClient     friend.FriendServiceClient
+	PresenceClient   presence.PresenceServiceClient
+	GroupClient      group.GroupServiceClient
+	AttachmentClient attachment.AttachmentServiceClient
 }
 
 // NewServiceContext 创建服务上下文实例
-func NewServiceContext(mysqlClient *mysql.MySQLClient, redisClient *redis.RedisClient, mongoClient *mongodb.MongoClient, kafkaProducer *kafka.KafkaProducer, kafkaConsumer *kafka.KafkaConsumer) *ServiceContext {
+func NewServiceContext(cfg config.Config, mysqlClient *mysql.MySQLClient, redisClient *redis.RedisClient, mongoClient *mongodb.MongoClient, kafkaProducer *kafka.KafkaProducer, router *kafka.Router, idGenerator *general.Snowflake, store storage.Storage) *ServiceContext {
 	return &ServiceContext{
+		Config:        cfg,
 		MySQLClient:   mysqlClient,
 		RedisClient:   redisClient,
 		MongoClient:   mongoClient,
 		KafkaProducer: kafkaProducer,
-		KafkaConsumer: kafkaConsumer,
+		KafkaConsumer: kafka.NewKafkaConsumer(cfg.Kafka.Brokers, cfg.Kafka.Topic, router),
+		NodeConsumer:  kafka.NewNodeConsumer(cfg.Kafka.Brokers, cfg.Kafka.Topic, router),
+		Router:        router,
+		IDGenerator:   idGenerator,
+		Storage:       store,
+	}
+}
+
+// InitRpcClients 为各 gRPC 服务各创建一个进程内共享的客户端连接
+func (sc *ServiceContext) InitRpcClients(lm *loadmonitor.LoadMonitor) error {
+	userConn, err := general.CreateGRPCConnection(sc.Config.UserRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到用户服务: %w", err)
+	}
+	sc.UserClient = user.NewUserServiceClient(userConn)
+
+	messageConn, err := general.CreateGRPCConnection(sc.Config.MessageRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到消息服务: %w", err)
+	}
+	sc.MessageClient = message.NewMessageServiceClient(messageConn)
+
+	friendConn, err := general.CreateGRPCConnection(sc.Config.FriendRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到好友服务: %w", err)
+	}
+	sc.FriendClient = friend.NewFriendServiceClient(friendConn)
+
+	presenceConn, err := general.CreateGRPCConnection(sc.Config.PresenceRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到在线状态服务: %w", err)
+	}
+	sc.PresenceClient = presence.NewPresenceServiceClient(presenceConn)
+
+	groupConn, err := general.CreateGRPCConnection(sc.Config.GroupRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到群组服务: %w", err)
+	}
+	sc.GroupClient = group.NewGroupServiceClient(groupConn)
+
+	attachmentConn, err := general.CreateGRPCConnection(sc.Config.AttachmentRpc.Endpoints, lm)
+	if err != nil {
+		return fmt.Errorf("无法连接到附件服务: %w", err)
 	}
+	sc.AttachmentClient = attachment.NewAttachmentServiceClient(attachmentConn)
+	return nil
 }
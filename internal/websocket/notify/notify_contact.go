@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyFriendRemoved 推送好友被删除，recipients 为本节点上操作者的连接所属用户
+func NotifyFriendRemoved(event *protocol.FriendRemovedEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_FriendRemoved{FriendRemoved: event}
+
+	// 用户不在线时直接丢弃，其他设备上线后可重新获取好友列表
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
+
+// NotifyBlockChanged 推送黑名单变化，recipients 为本节点上操作者的连接所属用户
+func NotifyBlockChanged(event *protocol.BlockChangedEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_BlockChanged{BlockChanged: event}
+
+	// 用户不在线时直接丢弃，其他设备上线后可重新获取黑名单
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
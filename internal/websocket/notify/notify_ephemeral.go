@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyEphemeral 推送输入状态等临时事件，recipients 为本节点上需要收到推送的用户
+func NotifyEphemeral(event *protocol.EphemeralEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_EphemeralEvent{EphemeralEvent: event}
+
+	// 临时事件不做任何补偿，找不到连接时直接丢弃
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
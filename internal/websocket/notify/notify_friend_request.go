@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyFriendRequest 推送好友请求状态变化，recipients 为本节点上需要收到推送的用户
+func NotifyFriendRequest(event *protocol.FriendRequestEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_FriendRequestEvent{FriendRequestEvent: event}
+
+	// 用户不在线时直接丢弃，上线后可查询好友请求列表
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
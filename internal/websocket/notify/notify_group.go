@@ -0,0 +1,16 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyGroupEvent 推送群组成员变化，recipients 为本节点上需要收到推送的用户
+func NotifyGroupEvent(event *protocol.GroupEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_GroupEvent{GroupEvent: event}
+
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyMessageChanged 推送消息撤回、编辑或删除，recipients 为本节点上需要收到推送的用户
+func NotifyMessageChanged(event *protocol.MessageChangedEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_MessageChanged{MessageChanged: event}
+
+	// 离线用户重新上线后通过历史记录或同步接口获取消息的最新状态
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
@@ -2,47 +2,40 @@ package notify
 
 import (
 	"fmt"
-	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+	"im-service/internal/protocol"
 	websocket2 "im-service/internal/websocket"
 )
 
-// NotifyNewMessage 通知新消息
-func NotifyNewMessage(content string) {
-	// 查找相关用户的 WebSocket 连接并发送消息
-	// 假设 content 格式为 "from|to|message"
-	parts := websocket2.SplitMessage(content)
-	if len(parts) != 3 {
-		fmt.Printf("无效的消息格式: %s\n", content)
-		return
-	}
-
-	from := parts[0]
-	to := parts[1]
-	message := parts[2]
-
+// NotifyNewMessage 通知新消息，recipients 为本节点上需要收到推送的用户
+func NotifyNewMessage(event *protocol.NewMessageEvent, recipients []string) {
 	// 调用已注册的监听器
-	if listener, ok := websocket2.RegisteredListeners[to]; ok {
-		listener(from, to, message)
+	if listener, ok := websocket2.GetMessageListener(event.To); ok {
+		listener(event.From, event.To, event.Content)
 	}
 
-	toConn, ok := websocket2.UserConnections[to]
-	if ok {
-		notification := fmt.Sprintf("你收到来自 %s 的新消息: %s", from, message)
-		if err := toConn.Conn.WriteMessage(websocket.TextMessage, []byte(notification)); err != nil {
-			fmt.Printf("向用户 %s 发送新消息通知失败: %v\n", to, err)
+	// 接收方的所有设备收到新消息，发送方的其他设备同步收到同一条消息
+	for _, recipient := range recipients {
+		frame := protocol.NewPush()
+		frame.Body = &protocol.Frame_NewMessage{NewMessage: eventFor(event, recipient)}
+		if websocket2.Connections.SendToUser(recipient, frame) == 0 {
+			fmt.Printf("用户 %s 的 WebSocket 连接未找到\n", recipient)
 		}
-	} else {
-		fmt.Printf("用户 %s 的 WebSocket 连接未找到\n", to)
 	}
 
-	fromConn, ok := websocket2.UserConnections[from]
-	if ok {
-		notification := fmt.Sprintf("你向好友 %s 发出的消息: %s", to, message)
-		if err := fromConn.Conn.WriteMessage(websocket.TextMessage, []byte(notification)); err != nil {
-			fmt.Printf("向用户 %s 发送新消息通知失败: %v\n", from, err)
+}
+
+// eventFor 返回推送给 recipient 的消息，加密消息只保留发给 recipient 各设备的密文，与离线收件箱中的内容一致
+func eventFor(event *protocol.NewMessageEvent, recipient string) *protocol.NewMessageEvent {
+	if len(event.EncryptedPayloads) == 0 {
+		return event
+	}
+	filtered := proto.Clone(event).(*protocol.NewMessageEvent)
+	filtered.EncryptedPayloads = nil
+	for _, payload := range event.EncryptedPayloads {
+		if payload.Recipient == recipient {
+			filtered.EncryptedPayloads = append(filtered.EncryptedPayloads, payload)
 		}
-	} else {
-		fmt.Printf("用户 %s 的 WebSocket 连接未找到\n", from)
 	}
-
+	return filtered
 }
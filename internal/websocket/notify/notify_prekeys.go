@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyPrekeysLow 推送设备的一次性预共享公钥不足，recipients 为本节点上需要收到推送的用户
+func NotifyPrekeysLow(event *protocol.PrekeysLowEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_PrekeysLow{PrekeysLow: event}
+
+	// 用户不在线时直接丢弃，设备上线后可查询剩余数量并补充
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
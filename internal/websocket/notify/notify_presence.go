@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyPresence 推送好友的在线状态变化，recipients 为本节点上需要收到推送的用户
+func NotifyPresence(event *protocol.PresenceEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_PresenceEvent{PresenceEvent: event}
+
+	// 在线状态只推送给在线的好友，离线的好友上线后自行查询
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
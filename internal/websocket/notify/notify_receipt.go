@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyReceipt 推送送达/已读回执，recipients 为本节点上需要收到推送的用户
+func NotifyReceipt(event *protocol.ReceiptEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_Receipt{Receipt: event}
+
+	// 回执只是状态同步，用户不在线时直接丢弃，重新上线后可通过查询接口获取
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
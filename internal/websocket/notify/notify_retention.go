@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyDisappearingTimerChanged 推送会话阅后即焚时长变化，recipients 为本节点上需要收到推送的用户
+func NotifyDisappearingTimerChanged(event *protocol.DisappearingTimerChangedEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_DisappearingTimerChanged{DisappearingTimerChanged: event}
+
+	// 用户不在线时直接丢弃，重新上线后可通过会话列表获取当前时长
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
+
+// NotifyMessagesExpired 推送消息过期，recipients 为本节点上需要收到推送的用户
+func NotifyMessagesExpired(event *protocol.MessagesExpiredEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_MessagesExpired{MessagesExpired: event}
+
+	// 用户不在线时直接丢弃，客户端也应按消息的到期时间自行删除
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
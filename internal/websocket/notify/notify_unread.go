@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"im-service/internal/protocol"
+	websocket2 "im-service/internal/websocket"
+)
+
+// NotifyUnreadChanged 推送未读数变化，recipients 为本节点上需要收到推送的用户
+func NotifyUnreadChanged(event *protocol.UnreadChangedEvent, recipients []string) {
+	frame := protocol.NewPush()
+	frame.Body = &protocol.Frame_UnreadChanged{UnreadChanged: event}
+
+	// 未读数以最新一次推送为准，用户不在线时直接丢弃，重新上线后可通过查询接口获取
+	for _, recipient := range recipients {
+		websocket2.Connections.SendToUser(recipient, frame)
+	}
+}
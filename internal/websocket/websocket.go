@@ -1,35 +1,341 @@
 package websocket
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"github.com/gorilla/websocket"
-	"strings"
+	"im-service/internal/protocol"
+	"log"
+	"sync"
+	"time"
 )
 
-// WebSocketConnection 定义 WebSocket 连接结构体
+const (
+	// sendBufferSize 每个连接待写出帧的缓冲数量，写满视为慢连接并断开
+	sendBufferSize = 256
+	// writeWait 单帧写超时
+	writeWait = 10 * time.Second
+)
+
+// ErrConnectionClosed 连接已关闭
+var ErrConnectionClosed = errors.New("连接已关闭")
+
+// ErrSendBufferFull 连接写缓冲已满
+var ErrSendBufferFull = errors.New("连接写缓冲已满")
+
+// ConnState 连接的认证状态
+type ConnState int
+
+const (
+	// StateUnauthenticated 连接建立后尚未登录或认证，只允许注册、登录、认证和心跳
+	StateUnauthenticated ConnState = iota
+	// StateAuthenticated 登录或认证成功，用户身份已绑定到连接
+	StateAuthenticated
+)
+
+// WebSocketConnection 定义 WebSocket 连接结构体，每个设备对应一个连接
+// 所有写操作都经由专属的写协程串行执行
 type WebSocketConnection struct {
-	Conn *websocket.Conn
+	ID    string
+	Conn  *websocket.Conn
+	Codec protocol.Codec
+
+	// mu 保护认证状态，username 与 token 为连接绑定的身份，由 ConnectionManager 维护
+	mu        sync.RWMutex
+	state     ConnState
+	username  string
+	token     string
+	expiresAt time.Time // token 的过期时间，零值表示不过期
+	deviceID  string    // 客户端上报的固定设备 ID，为空时以连接 ID 代替
+
+	send      chan outboundFrame
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
-// UserConnections 用户名 到 WebSocket 连接的映射
-var UserConnections = make(map[string]*WebSocketConnection)
+// outboundFrame 待写出的已编码帧
+type outboundFrame struct {
+	messageType int
+	data        []byte
+}
 
-// MessageListener 定义消息监听器函数类型
-type MessageListener func(from, to, message string)
+// NewWebSocketConnection 创建连接并启动写协程
+func NewWebSocketConnection(conn *websocket.Conn, codec protocol.Codec) *WebSocketConnection {
+	c := &WebSocketConnection{
+		ID:    newConnectionID(),
+		Conn:  conn,
+		Codec: codec,
+		send:  make(chan outboundFrame, sendBufferSize),
+		done:  make(chan struct{}),
+	}
+	go c.writeLoop()
+	return c
+}
+
+// WriteFrame 按连接协商的编码方式编码一帧并交给写协程
+func (c *WebSocketConnection) WriteFrame(frame *protocol.Frame) error {
+	data, err := c.Codec.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-c.done:
+		return ErrConnectionClosed
+	default:
+	}
+	select {
+	case c.send <- outboundFrame{messageType: c.Codec.MessageType(), data: data}:
+		return nil
+	case <-c.done:
+		return ErrConnectionClosed
+	default:
+		// 客户端消费过慢，断开连接避免拖垮推送方
+		log.Printf("连接 %s 写缓冲已满，断开连接", c.ID)
+		c.Close()
+		return ErrSendBufferFull
+	}
+}
+
+// WriteFrameWait 与 WriteFrame 相同，但写缓冲已满时最多等待 timeout 而不是断开连接
+// 用于补推离线消息等一次写出大量帧的场景，超时返回 ErrSendBufferFull，连接保持打开
+func (c *WebSocketConnection) WriteFrameWait(frame *protocol.Frame, timeout time.Duration) error {
+	data, err := c.Codec.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case c.send <- outboundFrame{messageType: c.Codec.MessageType(), data: data}:
+		return nil
+	case <-c.done:
+		return ErrConnectionClosed
+	case <-timer.C:
+		return ErrSendBufferFull
+	}
+}
+
+// Done 返回连接关闭时被关闭的通道
+func (c *WebSocketConnection) Done() <-chan struct{} {
+	return c.done
+}
+
+// State 返回连接的认证状态
+func (c *WebSocketConnection) State() ConnState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// Username 返回连接绑定的用户名，未认证时为空
+func (c *WebSocketConnection) Username() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.username
+}
+
+// Identity 返回连接绑定的用户名和 token，调用后端服务时使用该 token
+func (c *WebSocketConnection) Identity() (string, string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.username, c.token, c.state == StateAuthenticated
+}
+
+// SetDeviceID 记录客户端上报的设备 ID，在绑定身份之前调用；为空时忽略
+func (c *WebSocketConnection) SetDeviceID(deviceID string) {
+	if deviceID == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deviceID = deviceID
+}
+
+// DeviceID 返回连接所属的设备 ID，客户端未上报时使用连接 ID，每次重连都视为新设备
+func (c *WebSocketConnection) DeviceID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.deviceID != "" {
+		return c.deviceID
+	}
+	return c.ID
+}
+
+// Expired 判断连接绑定的 token 在 now 时是否已过期，未认证的连接不会过期
+func (c *WebSocketConnection) Expired(now time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state == StateAuthenticated && !c.expiresAt.IsZero() && !now.Before(c.expiresAt)
+}
+
+// setIdentity 切换连接的认证状态，userName 为空时回到未认证状态
+func (c *WebSocketConnection) setIdentity(userName, token string, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.username = userName
+	c.token = token
+	c.expiresAt = expiresAt
+	if userName == "" {
+		c.state = StateUnauthenticated
+	} else {
+		c.state = StateAuthenticated
+	}
+}
+
+// Close 关闭连接，可重复调用
+func (c *WebSocketConnection) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.Conn.Close()
+	})
+}
+
+// CloseWithReason 发送关闭帧说明原因后关闭连接
+func (c *WebSocketConnection) CloseWithReason(code int, reason string) {
+	// WriteControl 可以与写协程并发调用
+	if err := c.Conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeWait)); err != nil {
+		log.Printf("连接 %s 发送关闭帧失败: %v", c.ID, err)
+	}
+	c.Close()
+}
+
+// writeLoop 串行写出所有帧，连接关闭或写失败时退出
+func (c *WebSocketConnection) writeLoop() {
+	for {
+		select {
+		case frame := <-c.send:
+			if err := c.Conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				log.Printf("设置写超时失败: %v", err)
+				c.Close()
+				return
+			}
+			if err := c.Conn.WriteMessage(frame.messageType, frame.data); err != nil {
+				log.Printf("连接 %s 写入失败: %v", c.ID, err)
+				c.Close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// newConnectionID 生成随机连接 ID
+func newConnectionID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
+
+// ConnectionManager 管理用户到其所有设备连接的映射，并发安全
+type ConnectionManager struct {
+	mu    sync.RWMutex
+	users map[string]map[string]*WebSocketConnection
+}
+
+// NewConnectionManager 创建连接管理器
+func NewConnectionManager() *ConnectionManager {
+	return &ConnectionManager{
+		users: make(map[string]map[string]*WebSocketConnection),
+	}
+}
 
-// RegisteredListeners 存储已注册的监听器
-var RegisteredListeners = make(map[string]MessageListener)
+// Connections 进程内的连接管理器
+var Connections = NewConnectionManager()
 
-// RegisterConnection 注册用户的 WebSocket 连接
-func RegisterConnection(userName string, conn *websocket.Conn) {
-	UserConnections[userName] = &WebSocketConnection{Conn: conn}
+// Bind 将认证后的身份绑定到连接，同一连接重新登录其他用户时先解除旧绑定
+// 同一用户使用新 token 重新认证时更新 token 和过期时间，即刷新会话
+func (m *ConnectionManager) Bind(userName, token string, expiresAt time.Time, conn *WebSocketConnection) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if current := conn.Username(); current != "" && current != userName {
+		m.removeLocked(conn)
+	}
+	sessions, ok := m.users[userName]
+	if !ok {
+		sessions = make(map[string]*WebSocketConnection)
+		m.users[userName] = sessions
+	}
+	sessions[conn.ID] = conn
+	conn.setIdentity(userName, token, expiresAt)
 }
 
+// Remove 移除连接，连接断开时调用
+func (m *ConnectionManager) Remove(conn *WebSocketConnection) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.removeLocked(conn)
+}
+
+func (m *ConnectionManager) removeLocked(conn *WebSocketConnection) {
+	userName := conn.Username()
+	if userName == "" {
+		return
+	}
+	if sessions, ok := m.users[userName]; ok {
+		delete(sessions, conn.ID)
+		if len(sessions) == 0 {
+			delete(m.users, userName)
+		}
+	}
+	conn.setIdentity("", "", time.Time{})
+}
+
+// Get 返回用户当前所有设备的连接
+func (m *ConnectionManager) Get(userName string) []*WebSocketConnection {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	sessions := m.users[userName]
+	conns := make([]*WebSocketConnection, 0, len(sessions))
+	for _, conn := range sessions {
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+// IsOnline 判断用户在本节点是否有连接
+func (m *ConnectionManager) IsOnline(userName string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.users[userName]) > 0
+}
+
+// SendToUser 向用户的所有设备推送一帧，返回成功投递的连接数
+func (m *ConnectionManager) SendToUser(userName string, frame *protocol.Frame) int {
+	delivered := 0
+	for _, conn := range m.Get(userName) {
+		if err := conn.WriteFrame(frame); err != nil {
+			log.Printf("向用户 %s 的连接 %s 推送失败: %v", userName, conn.ID, err)
+			continue
+		}
+		delivered++
+	}
+	return delivered
+}
+
+// MessageListener 定义消息监听器函数类型
+type MessageListener func(from, to, message string)
+
+// registeredListeners 存储已注册的监听器
+var (
+	registeredListeners   = make(map[string]MessageListener)
+	registeredListenersMu sync.RWMutex
+)
+
 // RegisterMessageListener 注册消息监听器，根据用户名筛选消息
 func RegisterMessageListener(userName string, listener MessageListener) {
-	RegisteredListeners[userName] = listener
+	registeredListenersMu.Lock()
+	defer registeredListenersMu.Unlock()
+	registeredListeners[userName] = listener
 }
 
-// SplitMessage 辅助函数，用于分割消息
-func SplitMessage(message string) []string {
-	return strings.Split(message, "|")
+// GetMessageListener 获取用户注册的消息监听器
+func GetMessageListener(userName string) (MessageListener, bool) {
+	registeredListenersMu.RLock()
+	defer registeredListenersMu.RUnlock()
+	listener, ok := registeredListeners[userName]
+	return listener, ok
 }
@@ -0,0 +1,92 @@
+package websocket
+
+import (
+	"im-service/internal/protocol"
+	"testing"
+	"time"
+)
+
+func TestWebSocketConnectionExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		userName  string
+		expiresAt time.Time
+		want      bool
+	}{
+		{name: "未认证", want: false},
+		{name: "没有过期时间", userName: "alice", want: false},
+		{name: "尚未过期", userName: "alice", expiresAt: now.Add(time.Minute), want: false},
+		{name: "刚好过期", userName: "alice", expiresAt: now, want: true},
+		{name: "已过期", userName: "alice", expiresAt: now.Add(-time.Minute), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &WebSocketConnection{ID: "c1"}
+			conn.setIdentity(tt.userName, "token", tt.expiresAt)
+			if got := conn.Expired(now); got != tt.want {
+				t.Errorf("Expired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectionManagerBindRefreshesExpiry(t *testing.T) {
+	m := NewConnectionManager()
+	conn := &WebSocketConnection{ID: "c1"}
+	now := time.Now()
+	m.Bind("alice", "old", now.Add(-time.Minute), conn)
+	if !conn.Expired(now) {
+		t.Fatal("connection with an expired token should be expired")
+	}
+	// 同一用户用新 token 重新认证即刷新会话
+	m.Bind("alice", "new", now.Add(time.Hour), conn)
+	if conn.Expired(now) {
+		t.Error("re-binding with a fresh token should refresh the session")
+	}
+	if _, token, _ := conn.Identity(); token != "new" {
+		t.Errorf("token = %q, want new", token)
+	}
+	m.Remove(conn)
+	if conn.Expired(now) || m.IsOnline("alice") {
+		t.Error("removed connection should be unauthenticated and offline")
+	}
+}
+
+func TestWriteFrameWait(t *testing.T) {
+	conn := &WebSocketConnection{
+		ID:    "c1",
+		Codec: protocol.CodecForSubprotocol(""),
+		send:  make(chan outboundFrame, 1),
+		done:  make(chan struct{}),
+	}
+	if err := conn.WriteFrameWait(protocol.NewPush(), 10*time.Millisecond); err != nil {
+		t.Fatalf("first WriteFrameWait() error = %v", err)
+	}
+	// 缓冲已满时等待超时返回错误，但不关闭连接
+	if err := conn.WriteFrameWait(protocol.NewPush(), 10*time.Millisecond); err != ErrSendBufferFull {
+		t.Fatalf("WriteFrameWait() on a full buffer error = %v, want ErrSendBufferFull", err)
+	}
+	select {
+	case <-conn.Done():
+		t.Fatal("WriteFrameWait() closed the connection")
+	default:
+	}
+	// 写协程消费后可以继续写入
+	go func() { <-conn.send }()
+	if err := conn.WriteFrameWait(protocol.NewPush(), time.Second); err != nil {
+		t.Errorf("WriteFrameWait() after drain error = %v", err)
+	}
+}
+
+func TestDeviceID(t *testing.T) {
+	conn := &WebSocketConnection{ID: "c1"}
+	if got := conn.DeviceID(); got != "c1" {
+		t.Errorf("DeviceID() = %q, want the connection ID", got)
+	}
+	conn.SetDeviceID("phone")
+	conn.SetDeviceID("")
+	if got := conn.DeviceID(); got != "phone" {
+		t.Errorf("DeviceID() = %q, want phone", got)
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"im-service/config"
@@ -9,10 +11,15 @@ import (
 	"im-service/internal/data/mongodb"
 	"im-service/internal/data/mysql"
 	"im-service/internal/data/redis"
+	"im-service/internal/data/storage"
+	"im-service/internal/general"
 	"im-service/internal/loadmonitor"
 	"im-service/internal/middleware"
+	"im-service/internal/rpc/attachment"
 	"im-service/internal/rpc/friend"
+	"im-service/internal/rpc/group"
 	"im-service/internal/rpc/message"
+	"im-service/internal/rpc/presence"
 	"im-service/internal/rpc/user"
 	"im-service/internal/start"
 	"im-service/internal/svc"
@@ -21,6 +28,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 )
@@ -39,6 +47,18 @@ func main() {
 	if err != nil {
 		log.Fatalf("加载配置文件失败: %v", err)
 	}
+	if cfg.NodeID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.Fatalf("获取主机名失败: %v", err)
+		}
+		cfg.NodeID = hostname + ":" + strconv.Itoa(cfg.Port)
+	}
+	if cfg.ServiceToken == "" {
+		// 网关和后端服务在同一进程中，随机凭证即可；拆分部署时需要在配置中指定相同的值
+		cfg.ServiceToken = newServiceToken()
+	}
+	middleware.SetServiceToken(cfg.ServiceToken)
 
 	// 初始化数据库和消息队列客户端
 	mysqlClient, err := mysql.NewMySQLClient(cfg.MySQL.DataSource)
@@ -50,11 +70,69 @@ func main() {
 	if err != nil {
 		log.Fatalf("初始化 MongoDB 失败: %v", err)
 	}
+	// 好友关系、标签和群成员使用多文档事务写入，不支持事务时在启动时失败，而不是在第一次同意好友请求时
+	if err := mongoClient.CheckTransactions(context.Background()); err != nil {
+		log.Fatalf("检查 MongoDB 事务支持失败: %v", err)
+	}
+	if err := message.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("创建 MongoDB 索引失败: %v", err)
+	}
+	if err := attachment.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("创建 MongoDB 索引失败: %v", err)
+	}
+	if err := friend.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("创建 MongoDB 索引失败: %v", err)
+	}
+	if err := group.EnsureIndexes(context.Background(), mongoClient); err != nil {
+		log.Fatalf("创建 MongoDB 索引失败: %v", err)
+	}
+	// 会话索引为空时在服务启动前生成，避免新消息先写入后跳过生成
+	if err := message.BackfillConversations(context.Background(), mongoClient); err != nil {
+		log.Fatalf("生成会话索引失败: %v", err)
+	}
+	// 旧消息的搜索字段在后台补齐，不阻塞启动
+	go func() {
+		if err := message.BackfillSearchText(context.Background(), mongoClient); err != nil {
+			log.Printf("补齐消息搜索字段失败: %v", err)
+		}
+	}()
+	store, err := storage.NewStorage(cfg.Storage)
+	if err != nil {
+		log.Fatalf("初始化附件存储失败: %v", err)
+	}
 	kafkaProducer := kafka.NewKafkaProducer(cfg.Kafka.Brokers, cfg.Kafka.Topic)
-	kafkaConsumer := kafka.NewKafkaConsumer(cfg.Kafka.Brokers, cfg.Kafka.Topic)
+	router := kafka.NewRouter(cfg.NodeID, cfg.Kafka.Topic, redisClient, kafkaProducer)
+
+	idGenerator, err := general.NewSnowflake(context.Background(), redisClient)
+	if err != nil {
+		log.Fatalf("初始化消息 ID 生成器失败: %v", err)
+	}
+	go idGenerator.KeepAlive(context.Background())
 
 	// 创建服务上下文
-	sc := svc.NewServiceContext(mysqlClient, redisClient, mongoClient, kafkaProducer, kafkaConsumer)
+	sc := svc.NewServiceContext(cfg, mysqlClient, redisClient, mongoClient, kafkaProducer, router, idGenerator, store)
+
+	// 网关节点定期续期存活标记，并启动主 topic 和节点专属 topic 的消费者
+	log.Printf("网关节点 ID: %s", cfg.NodeID)
+	go router.KeepAlive(context.Background())
+	go func() {
+		if err := sc.KafkaConsumer.ConsumeMessages(); err != nil {
+			log.Printf("Kafka 消费者出现错误: %v", err)
+		}
+	}()
+	go func() {
+		if err := sc.NodeConsumer.ConsumeMessages(); err != nil {
+			log.Printf("Kafka 节点消费者出现错误: %v", err)
+		}
+	}()
+
+	// 清理过期消息，多个节点同时运行时每个周期只有一个节点执行
+	purger := message.NewCustomMessageServiceServer(kafkaProducer, mongoClient, redisClient, idGenerator, cfg.Message.RecallWindow, cfg.Message.Retention)
+	go purger.RunPurger(context.Background(), cfg.NodeID, cfg.Message.PurgeInterval, cfg.Message.PurgeBatchSize, cfg.Message.ArchiveExpired)
+
+	// 预先计算好友推荐，多个节点同时运行时每个周期只有一个节点执行
+	suggester := friend.NewCustomFriendServiceServer(kafkaProducer, mongoClient, redisClient, mysqlClient, cfg.Friend.RejectCooldown)
+	go suggester.RunSuggester(context.Background(), cfg.NodeID, cfg.Friend.SuggestInterval)
 
 	// 启动用户服务 gRPC 服务器
 	for _, endpoint := range cfg.UserRpc.Endpoints {
@@ -80,6 +158,30 @@ func main() {
 		log.Println("FriendRpc端点列表为空。跳过好友服务启动")
 	}
 
+	// 启动在线状态服务 gRPC 服务器
+	for _, endpoint := range cfg.PresenceRpc.Endpoints {
+		go startPresenceService(endpoint, sc)
+	}
+	if len(cfg.PresenceRpc.Endpoints) == 0 {
+		log.Println("PresenceRpc端点列表为空。跳过在线状态服务启动")
+	}
+
+	// 启动群组服务 gRPC 服务器
+	for _, endpoint := range cfg.GroupRpc.Endpoints {
+		go startGroupService(endpoint, sc)
+	}
+	if len(cfg.GroupRpc.Endpoints) == 0 {
+		log.Println("GroupRpc端点列表为空。跳过群组服务启动")
+	}
+
+	// 启动附件服务 gRPC 服务器
+	for _, endpoint := range cfg.AttachmentRpc.Endpoints {
+		go startAttachmentService(endpoint, sc)
+	}
+	if len(cfg.AttachmentRpc.Endpoints) == 0 {
+		log.Println("AttachmentRpc端点列表为空。跳过附件服务启动")
+	}
+
 	// 初始化负载监控系统
 	lm := loadmonitor.NewLoadMonitor("http://localhost:8081/report_load")
 	// 实际的服务实例端点
@@ -89,6 +191,11 @@ func main() {
 
 	lm.Start(endpoints, interval)
 
+	// 创建进程内共享的 gRPC 客户端，所有 WebSocket 连接复用
+	if err := sc.InitRpcClients(lm); err != nil {
+		log.Fatalf("初始化 gRPC 客户端失败: %v", err)
+	}
+
 	// 创建 RateLimiter
 	rateLimiter := middleware.NewRateLimiter(sc.RedisClient, 10, 100)
 
@@ -101,9 +208,14 @@ func main() {
 	// 注册 Prometheus 指标端点
 	metricsHandler.RegisterMetricsHandler()
 
+	// 本地存储时由网关提供附件下载，地址带签名和过期时间
+	if localStorage, ok := store.(*storage.LocalStorage); ok {
+		http.Handle(storage.LocalPathPrefix, localStorage)
+	}
+
 	// 启动 WebSocket 服务
 	http.HandleFunc("/ws", middleware.RateLimitMiddleware(rateLimiter, func(w http.ResponseWriter, r *http.Request) {
-		start.WsHandler(cfg, lm, w, r)
+		start.WsHandler(sc, w, r)
 	}))
 	log.Printf("启动WebSocket服务器，在端口 %d 上", cfg.Port)
 	if err := http.ListenAndServe(":"+strconv.Itoa(cfg.Port), nil); err != nil {
@@ -121,10 +233,15 @@ func startUserService(endpoint string, sc *svc.ServiceContext) {
 	if err != nil {
 		log.Fatalf("收听失败: %v", err)
 	}
+	// 注册和登录无需认证，设备公钥相关的方法需要认证
 	s := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(middleware.AuthMiddlewareExcept(
+			user.UserService_Register_FullMethodName,
+			user.UserService_Login_FullMethodName,
+		)),
 	)
-	userServer := user.NewCustomUserServiceServer(sc.MySQLClient, sc.RedisClient)
+	userServer := user.NewCustomUserServiceServer(sc.MySQLClient, sc.RedisClient, sc.MongoClient, sc.KafkaProducer)
 	user.RegisterUserServiceServer(s, userServer)
 	log.Printf("正在启动用户服务 %s", endpoint)
 	if err := s.Serve(lis); err != nil {
@@ -143,7 +260,7 @@ func startMessageService(endpoint string, sc *svc.ServiceContext) {
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.UnaryInterceptor(middleware.AuthMiddleware),
 	)
-	messageServer := message.NewCustomMessageServiceServer(sc.KafkaProducer, sc.MongoClient, sc.KafkaConsumer)
+	messageServer := message.NewCustomMessageServiceServer(sc.KafkaProducer, sc.MongoClient, sc.RedisClient, sc.IDGenerator, sc.Config.Message.RecallWindow, sc.Config.Message.Retention)
 	message.RegisterMessageServiceServer(s, messageServer)
 	log.Printf("正在启动消息服务 %s", endpoint)
 	if err := s.Serve(lis); err != nil {
@@ -162,7 +279,7 @@ func startFriendService(endpoint string, sc *svc.ServiceContext) {
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.UnaryInterceptor(middleware.AuthMiddleware),
 	)
-	friendServer := friend.NewCustomFriendServiceServer(sc.KafkaProducer, sc.MongoClient, sc.RedisClient, sc.KafkaConsumer)
+	friendServer := friend.NewCustomFriendServiceServer(sc.KafkaProducer, sc.MongoClient, sc.RedisClient, sc.MySQLClient, sc.Config.Friend.RejectCooldown)
 	friend.RegisterFriendServiceServer(s, friendServer)
 	log.Printf("正在启动好友服务 %s", endpoint)
 	if err := s.Serve(lis); err != nil {
@@ -170,3 +287,69 @@ func startFriendService(endpoint string, sc *svc.ServiceContext) {
 	}
 
 }
+
+// startPresenceService 启动在线状态服务 gRPC 服务器
+func startPresenceService(endpoint string, sc *svc.ServiceContext) {
+	lis, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		log.Fatalf("收听失败: %v", err)
+	}
+	// 创建 gRPC 服务器并注册拦截器
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(middleware.AuthMiddleware),
+	)
+	presenceServer := presence.NewCustomPresenceServiceServer(sc.KafkaProducer, sc.MongoClient, sc.RedisClient)
+	presence.RegisterPresenceServiceServer(s, presenceServer)
+	log.Printf("正在启动在线状态服务 %s", endpoint)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("无法为在线状态服务提供服务: %v", err)
+	}
+}
+
+// startGroupService 启动群组服务 gRPC 服务器
+func startGroupService(endpoint string, sc *svc.ServiceContext) {
+	lis, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		log.Fatalf("收听失败: %v", err)
+	}
+	// 创建 gRPC 服务器并注册拦截器
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(middleware.AuthMiddleware),
+	)
+	groupServer := group.NewCustomGroupServiceServer(sc.KafkaProducer, sc.MongoClient, sc.RedisClient)
+	group.RegisterGroupServiceServer(s, groupServer)
+	log.Printf("正在启动群组服务 %s", endpoint)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("无法为群组服务提供服务: %v", err)
+	}
+}
+
+// startAttachmentService 启动附件服务 gRPC 服务器
+func startAttachmentService(endpoint string, sc *svc.ServiceContext) {
+	lis, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		log.Fatalf("收听失败: %v", err)
+	}
+	// 创建 gRPC 服务器并注册拦截器
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(middleware.AuthMiddleware),
+	)
+	attachmentServer := attachment.NewCustomAttachmentServiceServer(sc.MongoClient, sc.Storage)
+	attachment.RegisterAttachmentServiceServer(s, attachmentServer)
+	log.Printf("正在启动附件服务 %s", endpoint)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("无法为附件服务提供服务: %v", err)
+	}
+}
+
+// newServiceToken 生成随机的服务间调用凭证
+func newServiceToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("生成服务凭证失败: %v", err)
+	}
+	return hex.EncodeToString(b)
+}